@@ -0,0 +1,68 @@
+package infermedica
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	MinAgeYears = 0   // Youngest age supported by the API, ages under one year are given in months
+	MaxAgeYears = 130 // Oldest age supported by the API
+)
+
+// Months returns the age in months
+func (a Age) Months() int {
+	if a.Unit == AgeUnitMonth {
+		return a.Value
+	}
+	return a.Value * 12
+}
+
+// Years returns the age in whole years
+func (a Age) Years() int {
+	if a.Unit == AgeUnitMonth {
+		return a.Value / 12
+	}
+	return a.Value
+}
+
+// Normalize returns the age in months when it is under one year and in years otherwise
+func (a Age) Normalize() Age {
+	if a.Months() < 12 {
+		return Age{Value: a.Months(), Unit: AgeUnitMonth}
+	}
+	return Age{Value: a.Years(), Unit: AgeUnitYear}
+}
+
+// IsValid returns an error when the unit is unknown or the age is out of the range supported by the API
+func (a *Age) IsValid() error {
+	switch a.Unit {
+	case "", AgeUnitYear, AgeUnitMonth:
+	default:
+		return fmt.Errorf("infermedica: unexpected value for age unit: %q", a.Unit)
+	}
+	if a.Value < 0 || a.Months() < MinAgeYears*12 || a.Months() > MaxAgeYears*12 {
+		return fmt.Errorf("infermedica: age out of the supported range (%d to %d years): %d %s", MinAgeYears, MaxAgeYears, a.Value, a.Unit)
+	}
+	return nil
+}
+
+// query returns the age as query parameters, the unit is omitted when empty so the API uses years
+func (a Age) query() string {
+	q := "age.value=" + strconv.Itoa(a.Value)
+	if a.Unit != "" {
+		q += "&age.unit=" + string(a.Unit)
+	}
+	return q
+}
+
+// Applies reports whether an item with the sex filter applies to a patient of the given sex
+func (s SexFilter) Applies(sex Sex) bool {
+	switch s {
+	case SexFilterMale:
+		return sex == SexMale
+	case SexFilterFemale:
+		return sex == SexFemale
+	}
+	return true
+}
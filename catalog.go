@@ -0,0 +1,203 @@
+package infermedica
+
+import "fmt"
+
+// Catalog holds the knowledge base items of one language indexed by ID
+type Catalog struct {
+	Language      Language                 `json:"language"`
+	Age           Age                      `json:"age"` // Age the catalog was loaded for, the API only returns the items that apply to it
+	EnableTriage3 bool                     `json:"enable_triage_3"`
+	Symptoms      map[string]SymptomRes    `json:"symptoms"`
+	Conditions    map[string]ConditionRes  `json:"conditions"`
+	RiskFactors   map[string]RiskFactorRes `json:"risk_factors"`
+	LabTests      map[string]LabTestsRes   `json:"lab_tests"`
+	Concepts      map[string]ConceptsRes   `json:"concepts"`
+}
+
+// Catalogs holds one Catalog per language, the IDs are the same in every language
+type Catalogs map[Language]*Catalog
+
+// LoadCatalog downloads symptoms, conditions, risk factors, lab tests and concepts using the model of the given language
+func (a *App) LoadCatalog(language Language, age Age, enableTriage3 bool, opts ...RequestOption) (*Catalog, error) {
+	if err := age.IsValid(); err != nil {
+		return nil, err
+	}
+	opts = append(opts[:len(opts):len(opts)], WithLanguage(language))
+	c := Catalog{
+		Language:      language,
+		Age:           age,
+		EnableTriage3: enableTriage3,
+		Symptoms:      map[string]SymptomRes{},
+		Conditions:    map[string]ConditionRes{},
+		RiskFactors:   map[string]RiskFactorRes{},
+		LabTests:      map[string]LabTestsRes{},
+		Concepts:      map[string]ConceptsRes{},
+	}
+
+	symptoms, err := a.Symptoms(age, enableTriage3, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range *symptoms {
+		c.Symptoms[s.ID] = s
+	}
+
+	conditions, err := a.Conditions(age, enableTriage3, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, cn := range *conditions {
+		c.Conditions[cn.ID] = cn
+	}
+
+	riskFactors, err := a.RiskFactors(age, enableTriage3, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, rf := range *riskFactors {
+		c.RiskFactors[rf.ID] = rf
+	}
+
+	labTests, err := a.LabTests(age, enableTriage3, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, lt := range *labTests {
+		c.LabTests[lt.ID] = lt
+	}
+
+	concepts, err := a.Concepts(opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, cp := range *concepts {
+		c.Concepts[cp.ID] = cp
+	}
+	return &c, nil
+}
+
+// FilterCatalog returns the items of the catalog that apply to a patient of the given sex and age.
+// The API decides which items apply to an age, so c is reused when it was loaded for the same age
+// and the catalog is loaded again, in the language of c, otherwise
+func (a *App) FilterCatalog(c *Catalog, sex Sex, age Age, opts ...RequestOption) (*Catalog, error) {
+	if err := sex.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := age.IsValid(); err != nil {
+		return nil, err
+	}
+	if !c.LoadedFor(age) {
+		var err error
+		c, err = a.LoadCatalog(c.Language, age, c.EnableTriage3, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.FilterSex(sex)
+}
+
+// LoadedFor reports whether the catalog was loaded for the given age, so it only has the items that apply to it.
+// Ages are compared in months, so 13 and 23 months are different ages while 24 months and 2 years are the same
+func (c *Catalog) LoadedFor(age Age) bool {
+	return c.Age.Months() == age.Months()
+}
+
+// FilterSex returns the items of the catalog that apply to a patient of the given sex
+func (c *Catalog) FilterSex(sex Sex) (*Catalog, error) {
+	if err := sex.IsValid(); err != nil {
+		return nil, err
+	}
+	f := Catalog{
+		Language:      c.Language,
+		Age:           c.Age,
+		EnableTriage3: c.EnableTriage3,
+		Symptoms:      map[string]SymptomRes{},
+		Conditions:    map[string]ConditionRes{},
+		RiskFactors:   map[string]RiskFactorRes{},
+		LabTests:      c.LabTests,
+		Concepts:      c.Concepts,
+	}
+	for id, s := range c.Symptoms {
+		if s.SexFilter.Applies(sex) {
+			f.Symptoms[id] = s
+		}
+	}
+	for id, cn := range c.Conditions {
+		if cn.SexFilter.Applies(sex) {
+			f.Conditions[id] = cn
+		}
+	}
+	for id, rf := range c.RiskFactors {
+		if rf.SexFilter.Applies(sex) {
+			f.RiskFactors[id] = rf
+		}
+	}
+	return &f, nil
+}
+
+// Applies reports whether an observation applies to a patient of the given sex and of the age the catalog
+// was loaded for: it must be a symptom, risk factor or lab test of the catalog and its sex filter must match
+func (c *Catalog) Applies(id string, sex Sex) bool {
+	if s, ok := c.Symptoms[id]; ok {
+		return s.SexFilter.Applies(sex)
+	}
+	if rf, ok := c.RiskFactors[id]; ok {
+		return rf.SexFilter.Applies(sex)
+	}
+	_, ok := c.LabTests[id]
+	return ok
+}
+
+// FilterQuestion removes the items of a question that do not apply to a patient of the given sex and age,
+// the catalog must have been loaded for that age (see FilterCatalog)
+func (c *Catalog) FilterQuestion(q Question, sex Sex, age Age) (Question, error) {
+	if err := sex.IsValid(); err != nil {
+		return q, err
+	}
+	if !c.LoadedFor(age) {
+		return q, fmt.Errorf("infermedica: catalog loaded for age %d %s, not %d %s", c.Age.Value, c.Age.Unit, age.Value, age.Unit)
+	}
+	items := make([]QuestionItem, 0, len(q.Items))
+	for _, item := range q.Items {
+		if c.Applies(item.ID, sex) {
+			items = append(items, item)
+		}
+	}
+	q.Items = items
+	return q, nil
+}
+
+// Names returns the name and common name of any item of the catalog
+func (c *Catalog) Names(id string) (name, commonName string, ok bool) {
+	if s, ok := c.Symptoms[id]; ok {
+		return s.Name, s.CommonName, true
+	}
+	if cn, ok := c.Conditions[id]; ok {
+		return cn.Name, cn.CommonName, true
+	}
+	if rf, ok := c.RiskFactors[id]; ok {
+		return rf.Name, rf.CommonName, true
+	}
+	if lt, ok := c.LabTests[id]; ok {
+		return lt.Name, lt.CommonName, true
+	}
+	if cp, ok := c.Concepts[id]; ok {
+		return cp.Name, cp.CommonName, true
+	}
+	return "", "", false
+}
+
+// CommonName returns the localized common name of an item, falling back to the english catalog
+// and then to the ID itself when the item is not found
+func (c Catalogs) CommonName(language Language, id string) string {
+	for _, l := range []Language{language, LanguageEnglish} {
+		catalog, ok := c[l]
+		if !ok {
+			continue
+		}
+		if _, commonName, ok := catalog.Names(id); ok {
+			return commonName
+		}
+	}
+	return id
+}
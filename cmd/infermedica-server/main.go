@@ -0,0 +1,78 @@
+// Command infermedica-server runs the interview gateway of package server.
+//
+// The App-Id and App-Key are read from INFERMEDICA_APP_ID and INFERMEDICA_APP_KEY, INFERMEDICA_MODEL is optional.
+// Clients are read from GATEWAY_CLIENTS as comma separated token:client pairs.
+//
+// Usage:
+//
+//	infermedica-server [--addr :8080] [--quota 60] [--quota-window 1m] [--session-ttl 24h] [--dev-mode]
+package main
+
+import (
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+	"github.com/guiarnaldo/infermedica-v3/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	quota := flag.Int("quota", 60, "requests allowed per client and window, no limit when zero")
+	window := flag.Duration("quota-window", time.Minute, "quota window")
+	ttl := flag.Duration("session-ttl", 24*time.Hour, "time an interview is kept without activity")
+	devMode := flag.Bool("dev-mode", false, "send the Dev-Mode header, for testing and development")
+	flag.Parse()
+
+	id := os.Getenv("INFERMEDICA_APP_ID")
+	key := os.Getenv("INFERMEDICA_APP_KEY")
+	if id == "" || key == "" {
+		log.Fatal("infermedica-server: INFERMEDICA_APP_ID and INFERMEDICA_APP_KEY must be set")
+	}
+	clients, err := parseClients(os.Getenv("GATEWAY_CLIENTS"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	app := infermedica.NewApp(id, key, infermedica.Model(os.Getenv("INFERMEDICA_MODEL")), "", infermedica.WithDevMode(*devMode))
+	s := server.New(&app, server.Config{
+		Clients: clients,
+		Store:   &server.MemoryStore{TTL: *ttl},
+		Quota:   server.Quota{Requests: *quota, Window: *window},
+	})
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           s,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       15 * time.Second,
+		WriteTimeout:      60 * time.Second, // Results make several API calls
+		IdleTimeout:       2 * time.Minute,
+	}
+	log.Printf("infermedica-server: listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+// parseClients parses "token1:client1,token2:client2"
+func parseClients(s string) (map[string]string, error) {
+	clients := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		token, client, ok := strings.Cut(pair, ":")
+		if !ok || token == "" || client == "" {
+			return nil, errors.New("infermedica-server: GATEWAY_CLIENTS must be comma separated token:client pairs")
+		}
+		clients[token] = client
+	}
+	if len(clients) == 0 {
+		return nil, errors.New("infermedica-server: GATEWAY_CLIENTS must be set")
+	}
+	return clients, nil
+}
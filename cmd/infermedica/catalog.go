@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+const catalogUsage = `usage: infermedica catalog <command> [flags]
+
+commands:
+  sync     download the catalog into a snapshot
+  show     print an item of a snapshot by ID with its hierarchy
+  search   search the items of a snapshot by name
+  diff     compare two snapshots
+`
+
+// snapshot is a catalog saved to a file
+type snapshot struct {
+	SyncedAt time.Time            `json:"synced_at"`
+	Catalog  *infermedica.Catalog `json:"catalog"`
+}
+
+// catalogItem is the common view of the items of every type
+type catalogItem struct {
+	Type       string      `json:"type"`
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	CommonName string      `json:"common_name"`
+	Item       interface{} `json:"item,omitempty"`
+}
+
+func runCatalog(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, catalogUsage)
+		return errors.New("infermedica: missing catalog command")
+	}
+	switch args[0] {
+	case "sync":
+		return runCatalogSync(args[1:])
+	case "show":
+		return runCatalogShow(args[1:])
+	case "search":
+		return runCatalogSearch(args[1:])
+	case "diff":
+		return runCatalogDiff(args[1:])
+	}
+	fmt.Fprint(os.Stderr, catalogUsage)
+	return fmt.Errorf("infermedica: unknown catalog command %q", args[0])
+}
+
+func runCatalogSync(args []string) error {
+	fs := flag.NewFlagSet("catalog sync", flag.ContinueOnError)
+	devMode := fs.Bool("dev-mode", false, "send the Dev-Mode header, for testing and development")
+	language := fs.String("language", "en", "language of the catalog")
+	ageValue := fs.Int("age", 30, "age the catalog is loaded for")
+	ageUnit := fs.String("age-unit", "year", "unit of the age, year or month")
+	triage3 := fs.Bool("triage3", false, "load the catalog for the 3-level triage")
+	output := fs.String("o", "catalog.json", "snapshot file")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	app, err := newApp(*devMode)
+	if err != nil {
+		return err
+	}
+	catalog, err := app.LoadCatalog(infermedica.Language(*language), infermedica.Age{Value: *ageValue, Unit: infermedica.AgeUnit(*ageUnit)}, *triage3)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(snapshot{SyncedAt: time.Now().UTC(), Catalog: catalog}, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(*output, b, 0o644)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d symptoms, %d conditions, %d risk factors, %d lab tests, %d concepts saved to %s\n",
+		len(catalog.Symptoms), len(catalog.Conditions), len(catalog.RiskFactors), len(catalog.LabTests), len(catalog.Concepts), *output)
+	return nil
+}
+
+func runCatalogShow(args []string) error {
+	fs := flag.NewFlagSet("catalog show", flag.ContinueOnError)
+	file := fs.String("snapshot", "catalog.json", "snapshot file")
+	format := fs.String("format", "table", "output format: table, json or csv")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("infermedica: usage: catalog show [flags] <id>")
+	}
+	s, err := readSnapshot(*file)
+	if err != nil {
+		return err
+	}
+	id := fs.Arg(0)
+	item, ok := findItem(s.Catalog, id)
+	if !ok {
+		return fmt.Errorf("infermedica: %q not found in %s", id, *file)
+	}
+
+	// The hierarchy goes from the root parent to the children of the item
+	var hierarchy []catalogItem
+	for parent := parentID(s.Catalog, id); parent != ""; parent = parentID(s.Catalog, parent) {
+		p, ok := findItem(s.Catalog, parent)
+		if !ok {
+			break
+		}
+		p.Type = "parent " + p.Type
+		hierarchy = append([]catalogItem{p}, hierarchy...)
+	}
+	hierarchy = append(hierarchy, item)
+	if symptom, ok := s.Catalog.Symptoms[id]; ok {
+		for _, child := range symptom.Children {
+			c, ok := findItem(s.Catalog, child.ID)
+			if !ok {
+				c = catalogItem{ID: child.ID}
+			}
+			c.Type = "child " + c.Type + " (" + child.ParentRelation + ")"
+			hierarchy = append(hierarchy, c)
+		}
+	}
+
+	value := struct {
+		catalogItem
+		Hierarchy []catalogItem `json:"hierarchy"`
+	}{catalogItem: item}
+	t := table{header: []string{"relation", "id", "name", "common_name"}}
+	for _, h := range hierarchy {
+		t.rows = append(t.rows, []string{h.Type, h.ID, h.Name, h.CommonName})
+		h.Item = nil
+		value.Hierarchy = append(value.Hierarchy, h)
+	}
+	t.value = value
+	return t.write(os.Stdout, *format)
+}
+
+func runCatalogSearch(args []string) error {
+	fs := flag.NewFlagSet("catalog search", flag.ContinueOnError)
+	file := fs.String("snapshot", "catalog.json", "snapshot file")
+	format := fs.String("format", "table", "output format: table, json or csv")
+	itemType := fs.String("type", "", "only items of this type: symptom, condition, risk_factor, lab_test or concept")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("infermedica: usage: catalog search [flags] <phrase>")
+	}
+	s, err := readSnapshot(*file)
+	if err != nil {
+		return err
+	}
+	phrase := strings.ToLower(strings.Join(fs.Args(), " "))
+
+	var found []catalogItem
+	for _, item := range catalogItems(s.Catalog) {
+		if *itemType != "" && item.Type != *itemType {
+			continue
+		}
+		if strings.Contains(strings.ToLower(item.Name), phrase) || strings.Contains(strings.ToLower(item.CommonName), phrase) {
+			item.Item = nil
+			found = append(found, item)
+		}
+	}
+	t := table{header: []string{"type", "id", "name", "common_name"}, value: found}
+	for _, f := range found {
+		t.rows = append(t.rows, []string{f.Type, f.ID, f.Name, f.CommonName})
+	}
+	return t.write(os.Stdout, *format)
+}
+
+// catalogChange is an item that was added, removed or changed between two snapshots
+type catalogChange struct {
+	Change string      `json:"change"`
+	Old    interface{} `json:"old,omitempty"`
+	New    interface{} `json:"new,omitempty"`
+	catalogItem
+}
+
+func runCatalogDiff(args []string) error {
+	fs := flag.NewFlagSet("catalog diff", flag.ContinueOnError)
+	format := fs.String("format", "table", "output format: table, json or csv")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("infermedica: usage: catalog diff [flags] <old snapshot> <new snapshot>")
+	}
+	old, err := readSnapshot(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	new, err := readSnapshot(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	oldItems := map[string]catalogItem{}
+	for _, item := range catalogItems(old.Catalog) {
+		oldItems[item.Type+"/"+item.ID] = item
+	}
+	var changes []catalogChange
+	for _, item := range catalogItems(new.Catalog) {
+		key := item.Type + "/" + item.ID
+		o, ok := oldItems[key]
+		delete(oldItems, key)
+		switch {
+		case !ok:
+			changes = append(changes, catalogChange{Change: "added", New: item.Item, catalogItem: item})
+		case !reflect.DeepEqual(o.Item, item.Item):
+			changes = append(changes, catalogChange{Change: "changed", Old: o.Item, New: item.Item, catalogItem: item})
+		}
+	}
+	for _, item := range oldItems {
+		changes = append(changes, catalogChange{Change: "removed", Old: item.Item, catalogItem: item})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].ID < changes[j].ID
+	})
+
+	t := table{header: []string{"change", "type", "id", "name", "common_name"}, value: changes}
+	for _, c := range changes {
+		t.rows = append(t.rows, []string{c.Change, c.Type, c.ID, c.Name, c.CommonName})
+	}
+	return t.write(os.Stdout, *format)
+}
+
+func readSnapshot(file string) (*snapshot, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var s snapshot
+	err = json.Unmarshal(b, &s)
+	if err != nil {
+		return nil, fmt.Errorf("infermedica: %s: %w", file, err)
+	}
+	if s.Catalog == nil {
+		return nil, fmt.Errorf("infermedica: %s: not a catalog snapshot", file)
+	}
+	return &s, nil
+}
+
+// catalogItems returns every item of the catalog sorted by type and ID
+func catalogItems(c *infermedica.Catalog) []catalogItem {
+	var items []catalogItem
+	for id, s := range c.Symptoms {
+		items = append(items, catalogItem{"symptom", id, s.Name, s.CommonName, s})
+	}
+	for id, cn := range c.Conditions {
+		items = append(items, catalogItem{"condition", id, cn.Name, cn.CommonName, cn})
+	}
+	for id, rf := range c.RiskFactors {
+		items = append(items, catalogItem{"risk_factor", id, rf.Name, rf.CommonName, rf})
+	}
+	for id, lt := range c.LabTests {
+		items = append(items, catalogItem{"lab_test", id, lt.Name, lt.CommonName, lt})
+	}
+	for id, cp := range c.Concepts {
+		items = append(items, catalogItem{"concept", id, cp.Name, cp.CommonName, cp})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Type != items[j].Type {
+			return items[i].Type < items[j].Type
+		}
+		return items[i].ID < items[j].ID
+	})
+	return items
+}
+
+func findItem(c *infermedica.Catalog, id string) (catalogItem, bool) {
+	for _, item := range catalogItems(c) {
+		if item.ID == id && item.Type != "concept" {
+			return item, true
+		}
+	}
+	for _, item := range catalogItems(c) {
+		if item.ID == id {
+			return item, true
+		}
+	}
+	return catalogItem{}, false
+}
+
+func parentID(c *infermedica.Catalog, id string) string {
+	if s, ok := c.Symptoms[id]; ok {
+		return s.ParentID
+	}
+	return ""
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+func runInterview(args []string) error {
+	fs := flag.NewFlagSet("interview", flag.ContinueOnError)
+	devMode := fs.Bool("dev-mode", false, "send the Dev-Mode header, for testing and development")
+	maxQuestions := fs.Int("max-questions", 30, "stop the interview after this number of questions")
+	top := fs.Int("top", 5, "number of conditions to print")
+	duration := fs.Bool("symptom-duration", false, "ask duration questions")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	app, err := newApp(*devMode)
+	if err != nil {
+		return err
+	}
+	i := interview{
+		app:             &app,
+		p:               newPrompter(os.Stdin, os.Stdout),
+		out:             os.Stdout,
+		maxQuestions:    *maxQuestions,
+		top:             *top,
+		symptomDuration: *duration,
+	}
+	return i.run()
+}
+
+type interview struct {
+	app             *infermedica.App
+	p               *prompter
+	out             io.Writer
+	maxQuestions    int
+	top             int
+	symptomDuration bool
+
+	sex       infermedica.Sex
+	age       infermedica.Age
+	evidences []infermedica.Evidence
+}
+
+func (i *interview) run() error {
+	err := i.askDemographics()
+	if err != nil {
+		return err
+	}
+	err = i.askSymptoms()
+	if err != nil {
+		return err
+	}
+
+	for n := 0; ; n++ {
+		diagnosis, err := i.app.Diagnosis(infermedica.DiagnosisReq{
+			Sex:       i.sex,
+			Age:       i.age,
+			Evidences: i.evidences,
+			Extras: &infermedica.DiagnosisReqExtras{
+				EnableSymptomDuration: i.symptomDuration,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if diagnosis.ShouldStop || len(diagnosis.Question.Items) == 0 || n == i.maxQuestions {
+			return i.printResults(diagnosis)
+		}
+		err = i.ask(diagnosis.Question)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (i *interview) askDemographics() error {
+	n, err := i.p.choose("Sex", []string{"Male", "Female"})
+	if err != nil {
+		return err
+	}
+	i.sex = []infermedica.Sex{infermedica.SexMale, infermedica.SexFemale}[n]
+
+	for {
+		answer, err := i.p.line("Age (years, or e.g. \"8 months\") > ")
+		if err != nil {
+			return err
+		}
+		age, err := parseAge(answer)
+		if err == nil {
+			err = age.IsValid()
+		}
+		if err == nil {
+			i.age = age
+			return nil
+		}
+		fmt.Fprintln(i.out, err)
+	}
+}
+
+func parseAge(s string) (infermedica.Age, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 || len(fields) > 2 {
+		return infermedica.Age{}, fmt.Errorf("infermedica: can not parse age: %q", s)
+	}
+	value, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return infermedica.Age{}, fmt.Errorf("infermedica: can not parse age: %q", s)
+	}
+	age := infermedica.Age{Value: value, Unit: infermedica.AgeUnitYear}
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "year", "years", "y":
+		case "month", "months", "m":
+			age.Unit = infermedica.AgeUnitMonth
+		default:
+			return infermedica.Age{}, fmt.Errorf("infermedica: can not parse age: %q", s)
+		}
+	}
+	return age, nil
+}
+
+func (i *interview) askSymptoms() error {
+	intake := infermedica.NewIntake(i.app, i.sex, i.age)
+	fmt.Fprintln(i.out, "Describe the symptoms, an empty line to finish")
+	for {
+		text, err := i.p.line("> ")
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if text == "" {
+			break
+		}
+		res, err := intake.Add(text)
+		if err != nil {
+			return err
+		}
+		for _, m := range res.Mentions {
+			fmt.Fprintf(i.out, "  recognized: %s (%s)\n", m.CommonName, m.ChoiceID)
+		}
+		if len(res.Mentions) == 0 {
+			fmt.Fprintln(i.out, "  no symptom recognized, try other words")
+		}
+	}
+	i.evidences = intake.Evidence()
+	if len(i.evidences) == 0 {
+		return errors.New("infermedica: no symptom recognized")
+	}
+	return nil
+}
+
+// ask asks a diagnosis question and records the answer
+func (i *interview) ask(q infermedica.Question) error {
+	fmt.Fprintln(i.out)
+	switch q.Type {
+	case infermedica.QuestionTypeSingle:
+		if len(q.Items) == 0 {
+			return fmt.Errorf("infermedica: no item to answer %q", q.Text)
+		}
+		item := q.Items[0]
+		n, err := i.p.choose(q.Text, choiceLabels(item))
+		if err != nil {
+			return err
+		}
+		i.answer(item.ID, item.Choices[n].ID)
+	case infermedica.QuestionTypeGroupSingle:
+		names := make([]string, len(q.Items))
+		for j, item := range q.Items {
+			names[j] = item.Name
+		}
+		n, err := i.p.choose(q.Text, names)
+		if err != nil {
+			return err
+		}
+		i.answer(q.Items[n].ID, infermedica.EvidenceChoiceIDPresent)
+	case infermedica.QuestionTypeGroupMultiple:
+		fmt.Fprintln(i.out, q.Text)
+		for _, item := range q.Items {
+			n, err := i.p.choose(item.Name, choiceLabels(item))
+			if err != nil {
+				return err
+			}
+			i.answer(item.ID, item.Choices[n].ID)
+		}
+	case infermedica.QuestionTypeDuration:
+		for {
+			answer, err := i.p.line(q.Text + " (e.g. \"3 days\") > ")
+			if err != nil {
+				return err
+			}
+			d, err := infermedica.ParseDuration(answer)
+			if err == nil {
+				err = infermedica.AttachDuration(i.evidences, q, d)
+			}
+			if err == nil {
+				return nil
+			}
+			fmt.Fprintln(i.out, err)
+		}
+	default:
+		return fmt.Errorf("infermedica: unexpected question type: %q", q.Type)
+	}
+	return nil
+}
+
+func (i *interview) answer(id string, choice infermedica.EvidenceChoiceID) {
+	i.evidences = infermedica.MergeEvidence(i.evidences, []infermedica.Evidence{{ID: id, ChoiceID: choice}}, infermedica.ConflictRulePreferLast)
+}
+
+func choiceLabels(item infermedica.QuestionItem) []string {
+	labels := make([]string, len(item.Choices))
+	for j, c := range item.Choices {
+		labels[j] = c.Label
+	}
+	return labels
+}
+
+func (i *interview) printResults(diagnosis *infermedica.DiagnosisRes) error {
+	fmt.Fprintln(i.out, "\nConditions")
+	for n, c := range diagnosis.Conditions {
+		if n == i.top {
+			break
+		}
+		fmt.Fprintf(i.out, "  %5.1f%%  %s\n", c.Probability*100, c.CommonName)
+	}
+
+	triage, err := i.app.Triage(infermedica.TriageReq{
+		Sex:       i.sex,
+		Age:       i.age,
+		Evidences: i.evidences,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(i.out, "\nTriage: %s\n", triage.TriageLevel)
+	for _, s := range triage.Serious {
+		fmt.Fprintf(i.out, "  %s (%s)\n", s.CommonName, s.Seriousness)
+	}
+
+	specialist, err := i.app.RecommendSpecialist(infermedica.RecommendSpecialistReq{
+		Sex:       i.sex,
+		Age:       i.age,
+		Evidences: i.evidences,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(i.out, "\nSpecialist: %s (%s)\n", specialist.RecommendedSpecialist.Name, specialist.RecommendedChannel)
+	return nil
+}
@@ -0,0 +1,74 @@
+// Command infermedica runs Infermedica API workflows from a terminal.
+//
+// Credentials are read from the INFERMEDICA_APP_ID and INFERMEDICA_APP_KEY environment variables,
+// INFERMEDICA_MODEL and INFERMEDICA_INTERVIEW_ID are optional.
+//
+// Usage:
+//
+//	infermedica interview [--dev-mode] [--max-questions n]
+//	infermedica catalog sync|show|search|diff [flags]
+//	infermedica vignettes [--concurrency n] [--junit file] <file.jsonl|file.yaml>...
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+const usage = `usage: infermedica <command> [flags]
+
+commands:
+  interview   run a symptom interview
+  catalog     sync, show, search and diff catalog snapshots
+  vignettes   replay clinical vignettes and check the expected results
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "interview":
+		err = runInterview(os.Args[2:])
+	case "catalog":
+		err = runCatalog(os.Args[2:])
+	case "vignettes":
+		err = runVignettes(os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "infermedica: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// newApp returns an App with the credentials of the environment
+func newApp(devMode bool) (infermedica.App, error) {
+	id := os.Getenv("INFERMEDICA_APP_ID")
+	key := os.Getenv("INFERMEDICA_APP_KEY")
+	if id == "" || key == "" {
+		return infermedica.App{}, fmt.Errorf("infermedica: INFERMEDICA_APP_ID and INFERMEDICA_APP_KEY must be set")
+	}
+	interviewID := os.Getenv("INFERMEDICA_INTERVIEW_ID")
+	if interviewID == "" {
+		interviewID = newInterviewID()
+	}
+	return infermedica.NewApp(id, key, infermedica.Model(os.Getenv("INFERMEDICA_MODEL")), interviewID, infermedica.WithDevMode(devMode)), nil
+}
+
+func newInterviewID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// table is tabular output that can also be written as JSON
+type table struct {
+	header []string
+	rows   [][]string
+	value  interface{} // Written instead of the rows in the json format
+}
+
+func (t *table) write(out io.Writer, format string) error {
+	switch format {
+	case "table":
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		for _, r := range t.rows {
+			fmt.Fprintln(w, strings.Join(r, "\t"))
+		}
+		return w.Flush()
+	case "csv":
+		w := csv.NewWriter(out)
+		err := w.Write(t.header)
+		if err != nil {
+			return err
+		}
+		err = w.WriteAll(t.rows)
+		if err != nil {
+			return err
+		}
+		return w.Error()
+	case "json":
+		e := json.NewEncoder(out)
+		e.SetIndent("", "  ")
+		return e.Encode(t.value)
+	default:
+		return fmt.Errorf("infermedica: unexpected output format: %q", format)
+	}
+}
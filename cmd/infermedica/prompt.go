@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// prompter asks questions on a terminal
+type prompter struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+func newPrompter(in io.Reader, out io.Writer) *prompter {
+	return &prompter{in: bufio.NewScanner(in), out: out}
+}
+
+// line prints the question and returns the trimmed answer
+func (p *prompter) line(question string) (string, error) {
+	fmt.Fprint(p.out, question)
+	if !p.in.Scan() {
+		if err := p.in.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return strings.TrimSpace(p.in.Text()), nil
+}
+
+// choose prints numbered options and returns the index of the chosen one, it asks again until the answer is valid.
+// It fails when there are no options to choose from
+func (p *prompter) choose(question string, options []string) (int, error) {
+	if len(options) == 0 {
+		return 0, fmt.Errorf("infermedica: no options to answer %q", question)
+	}
+	fmt.Fprintln(p.out, question)
+	for i, o := range options {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, o)
+	}
+	for {
+		answer, err := p.line("> ")
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(answer)
+		if err == nil && n >= 1 && n <= len(options) {
+			return n - 1, nil
+		}
+		fmt.Fprintf(p.out, "Enter a number from 1 to %d\n", len(options))
+	}
+}
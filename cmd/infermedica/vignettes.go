@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+
+	"github.com/guiarnaldo/infermedica-v3/vignette"
+)
+
+func runVignettes(args []string) error {
+	fs := flag.NewFlagSet("vignettes", flag.ContinueOnError)
+	devMode := fs.Bool("dev-mode", false, "send the Dev-Mode header, for testing and development")
+	concurrency := fs.Int("concurrency", 4, "number of vignettes run at the same time")
+	junit := fs.String("junit", "", "write a JUnit XML report to this file")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("infermedica: usage: vignettes [flags] <file.jsonl|file.yaml>...")
+	}
+	app, err := newApp(*devMode)
+	if err != nil {
+		return err
+	}
+
+	var vignettes []vignette.Vignette
+	for _, file := range fs.Args() {
+		v, err := vignette.Load(file)
+		if err != nil {
+			return err
+		}
+		vignettes = append(vignettes, v...)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	runner := vignette.Runner{App: &app, Concurrency: *concurrency}
+	results := runner.Run(ctx, vignettes)
+
+	err = vignette.WriteReport(os.Stdout, results)
+	if err != nil {
+		return err
+	}
+	if *junit != "" {
+		f, err := os.Create(*junit)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		err = vignette.WriteJUnit(f, "vignettes", results)
+		if err != nil {
+			return err
+		}
+	}
+	if s := vignette.Summarize(results); s.Failed > 0 || s.Errors > 0 {
+		return errors.New("infermedica: some vignettes did not pass")
+	}
+	return nil
+}
@@ -1,21 +1,21 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"net/http"
 	"time"
 )
 
 type ConceptsRes struct {
-	ID         string `json:"id"`
-	Type       string `json:"type"`
-	Name       string `json:"name"`
-	CommonName string `json:"common_name"`
+	ID         string  `json:"id"`
+	Type       string  `json:"type"`
+	Name       string  `json:"name"`
+	CommonName string  `json:"common_name"`
+	Unknown    Unknown `json:"-"` // Only when WithUnknownFields is used
 }
 
 // Concepts returns all concepts
-func (a *App) Concepts() (*[]ConceptsRes, error) {
-	req, err := a.prepareRequest("GET", "concepts", nil)
+func (a *App) Concepts(opts ...RequestOption) (*[]ConceptsRes, error) {
+	req, err := a.prepareRequest("GET", "concepts", nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -32,15 +32,15 @@ func (a *App) Concepts() (*[]ConceptsRes, error) {
 		return nil, err
 	}
 	var r []ConceptsRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-func (a *App) ConceptsByID(id string) (*ConceptsRes, error) {
-	req, err := a.prepareRequest("GET", "concepts/"+id, nil)
+func (a *App) ConceptsByID(id string, opts ...RequestOption) (*ConceptsRes, error) {
+	req, err := a.prepareRequest("GET", "concepts/"+id, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ func (a *App) ConceptsByID(id string) (*ConceptsRes, error) {
 		return nil, err
 	}
 	var r ConceptsRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -41,6 +40,28 @@ func PrevalenceFromString(x string) (Prevalence, error) {
 	}
 }
 
+func (p Prevalence) String() string {
+	return string(p)
+}
+
+func (p *Prevalence) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, p, PrevalenceFromString)
+}
+
+// prevalenceRank orders prevalence from the rarest to the most common
+var prevalenceRank = map[Prevalence]int{
+	PrevalenceVeryRare: 1,
+	PrevalenceRare:     2,
+	PrevalenceModerate: 3,
+	PrevalenceCommon:   4,
+}
+
+// Compare returns -1 if p is rarer than other, 1 if it is more common and 0 if they are equal.
+// Unknown values are rarer than every known value
+func (p Prevalence) Compare(other Prevalence) int {
+	return compareRank(prevalenceRank[p], prevalenceRank[other])
+}
+
 type Acuteness string
 
 const (
@@ -73,6 +94,14 @@ func AcutenessFromString(x string) (Acuteness, error) {
 	}
 }
 
+func (a Acuteness) String() string {
+	return string(a)
+}
+
+func (a *Acuteness) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, a, AcutenessFromString)
+}
+
 type Severity string
 
 const (
@@ -102,23 +131,61 @@ func SeverityFromString(x string) (Severity, error) {
 	}
 }
 
+func (s Severity) String() string {
+	return string(s)
+}
+
+func (s *Severity) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, s, SeverityFromString)
+}
+
+// severityRank orders severity from the mildest to the most severe
+var severityRank = map[Severity]int{
+	SeverityMild:     1,
+	SeverityModerate: 2,
+	SeveritySevere:   3,
+}
+
+// Compare returns -1 if s is milder than other, 1 if it is more severe and 0 if they are equal.
+// Unknown values are milder than every known value
+func (s Severity) Compare(other Severity) int {
+	return compareRank(severityRank[s], severityRank[other])
+}
+
+// MoreSevereThan reports whether s is more severe than other
+func (s Severity) MoreSevereThan(other Severity) bool {
+	return s.Compare(other) > 0
+}
+
+// MaxSeverity returns the most severe value, or "" when none is given
+func MaxSeverity(s ...Severity) Severity {
+	var max Severity
+	for _, x := range s {
+		if max == "" || x.MoreSevereThan(max) {
+			max = x
+		}
+	}
+	return max
+}
+
 type ConditionRes struct {
-	ID         string   `json:"id"`
-	Name       string   `json:"name"`
-	CommonName string   `json:"common_name"`
-	SexFilter  string   `json:"sex_filter"`
-	Categories []string `json:"categories"`
-	Prevalence string   `json:"prevalence"`
-	Acuteness  string   `json:"acuteness"`
-	Severity   string   `json:"severity"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CommonName string     `json:"common_name"`
+	SexFilter  SexFilter  `json:"sex_filter"`
+	Categories []string   `json:"categories"`
+	Prevalence Prevalence `json:"prevalence"`
+	Acuteness  Acuteness  `json:"acuteness"`
+	Severity   Severity   `json:"severity"`
 	Extras     struct {
 		Hint      string `json:"hint"`
 		Icd10Code string `json:"icd10_code"`
 	} `json:"extras"`
+	Unknown Unknown `json:"-"` // Only when WithUnknownFields is used
 }
 
-func (a *App) Conditions(age Age, enableTriage3 bool) (*[]ConditionRes, error) {
-	req, err := a.prepareRequest("GET", "conditions?age.value="+strconv.Itoa(age.Value)+"&age.unit"+string(age.Unit)+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil)
+func (a *App) Conditions(age Age, enableTriage3 bool, opts ...RequestOption) (*[]ConditionRes, error) {
+	req, err := a.prepareRequest("GET", "conditions?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -137,15 +204,15 @@ func (a *App) Conditions(age Age, enableTriage3 bool) (*[]ConditionRes, error) {
 		return nil, err
 	}
 	var r []ConditionRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-func (a *App) ConditionByID(id string, age Age, enableTriage3 bool) (*ConditionRes, error) {
-	req, err := a.prepareGETRequest("conditions/" + id + "?age.value=" + strconv.Itoa(age.Value) + "&age.unit" + string(age.Unit) + "&enableTriage3=" + strconv.FormatBool(enableTriage3))
+func (a *App) ConditionByID(id string, age Age, enableTriage3 bool, opts ...RequestOption) (*ConditionRes, error) {
+	req, err := a.prepareGETRequest("conditions/"+id+"?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +231,7 @@ func (a *App) ConditionByID(id string, age Age, enableTriage3 bool) (*ConditionR
 		return nil, err
 	}
 	var r ConditionRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,174 @@
+package infermedica
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Unknown holds the JSON fields of a response that the library does not model, keyed by their path
+// in the response (e.g. "conditions[0].new_field"). It is only filled when WithUnknownFields is used
+type Unknown map[string]json.RawMessage
+
+var (
+	unknownType     = reflect.TypeOf(Unknown(nil))
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// DecodeError is returned when a response of the API can not be decoded, or is rejected by WithStrictDecoding
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decode decodes a response body into v, keeping or rejecting unknown fields and enum values according to the options.
+// Unknown enum values are logged, or rejected with WithStrictDecoding
+func (a *App) decode(r io.Reader, v interface{}, opts []RequestOption) error {
+	o := a.requestOptions(opts)
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return &DecodeError{err}
+	}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	if o.strictDecoding {
+		d.DisallowUnknownFields()
+	}
+	err = d.Decode(v)
+	if err != nil {
+		if o.strictDecoding {
+			return &DecodeError{fmt.Errorf("infermedica: strict decoding: %w", err)}
+		}
+		return &DecodeError{err}
+	}
+
+	for _, err := range validateEnums(reflect.ValueOf(v), "") {
+		if o.strictDecoding {
+			return &DecodeError{err}
+		}
+		log.Print(err)
+	}
+	if o.unknownFields {
+		collectUnknown(raw, reflect.ValueOf(v), "", nil)
+	}
+	return nil
+}
+
+// collectUnknown walks raw alongside v and stores the fields that v does not model in the nearest Unknown field.
+// Types that implement json.Unmarshaler decode the whole value themselves, so they have no unknown fields
+// (e.g. the attributes of DiagnosisResExtras), as with WithStrictDecoding
+func collectUnknown(raw json.RawMessage, v reflect.Value, path string, unknown Unknown) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Type().Implements(unmarshalerType) || reflect.PointerTo(v.Type()).Implements(unmarshalerType) {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if json.Unmarshal(raw, &items) != nil {
+			return
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			collectUnknown(items[i], v.Index(i), path+"["+strconv.Itoa(i)+"]", unknown)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		var items map[string]json.RawMessage
+		if json.Unmarshal(raw, &items) != nil {
+			return
+		}
+		for key, item := range items {
+			k := reflect.ValueOf(key).Convert(v.Type().Key())
+			value := v.MapIndex(k)
+			if !value.IsValid() {
+				continue
+			}
+			// Map values are not addressable, the Unknown fields are set on a copy that replaces the value
+			elem := reflect.New(value.Type()).Elem()
+			elem.Set(value)
+			collectUnknown(item, elem, joinPath(path, key), unknown)
+			v.SetMapIndex(k, elem)
+		}
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(raw, &fields) != nil {
+			return
+		}
+		var own reflect.Value
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.Type == unknownType {
+				own = v.Field(i)
+			}
+		}
+		target := unknown
+		prefix := path
+		if own.IsValid() {
+			// Paths are relative to the struct that holds them
+			target = Unknown{}
+			prefix = ""
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			name := jsonName(f)
+			if name == "" {
+				continue
+			}
+			if value, ok := fields[name]; ok {
+				collectUnknown(value, v.Field(i), joinPath(prefix, name), target)
+				delete(fields, name)
+			}
+		}
+		if target == nil {
+			return
+		}
+		for name, value := range fields {
+			target[joinPath(prefix, name)] = value
+		}
+		if own.IsValid() && len(target) > 0 {
+			own.Set(reflect.ValueOf(target))
+		}
+	}
+}
+
+// jsonName returns the JSON name of an exported struct field, or "" when it is not encoded
+func jsonName(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
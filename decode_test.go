@@ -0,0 +1,173 @@
+package infermedica
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type decodeTestItem struct {
+	ID      string  `json:"id"`
+	Unknown Unknown `json:"-"`
+}
+
+type decodeTestRes struct {
+	Items  map[string]decodeTestItem `json:"items"`
+	Levels map[string]TriageLevel    `json:"levels"`
+	Nested struct {
+		Name string `json:"name"`
+	} `json:"nested"`
+	Unknown Unknown `json:"-"`
+}
+
+func keys(u Unknown) []string {
+	k := make([]string, 0, len(u))
+	for name := range u {
+		k = append(k, name)
+	}
+	sort.Strings(k)
+	return k
+}
+
+func TestDecodeUnknownFields(t *testing.T) {
+	app := NewApp("id", "key", "", "")
+	body := `{
+		"question": {"type": "single", "text": "?", "items": [{"id": "s_1", "name": "Fever", "new_item": 1}], "extras": {"z": 1}},
+		"conditions": [{"id": "c_1"}, {"id": "c_2", "new_field": "x"}],
+		"extras": {"a": 2},
+		"new_top": true
+	}`
+	var res DiagnosisRes
+	err := app.decode(strings.NewReader(body), &res, []RequestOption{WithUnknownFields(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"conditions[1].new_field", "new_top", "question.items[0].new_item"}
+	if got := keys(res.Unknown); !reflect.DeepEqual(got, want) {
+		t.Errorf("Unknown = %v, want %v", got, want)
+	}
+	if _, ok := res.Question.Extras.Attributes["z"]; !ok {
+		t.Errorf("question extras attribute z not kept: %v", res.Question.Extras.Attributes)
+	}
+	if _, ok := res.Extras.Attributes["a"]; !ok {
+		t.Errorf("extras attribute a not kept: %v", res.Extras.Attributes)
+	}
+}
+
+func TestDecodeUnknownFieldsNested(t *testing.T) {
+	app := NewApp("id", "key", "", "")
+	body := `{
+		"items": {"a": {"id": "a", "new": 1}, "b": {"id": "b"}},
+		"nested": {"name": "n", "extra": [1]},
+		"other": null
+	}`
+	var res decodeTestRes
+	err := app.decode(strings.NewReader(body), &res, []RequestOption{WithUnknownFields(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := keys(res.Unknown), []string{"nested.extra", "other"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unknown = %v, want %v", got, want)
+	}
+	// Items with their own Unknown field keep the paths relative to them
+	if got, want := keys(res.Items["a"].Unknown), []string{"new"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("items.a Unknown = %v, want %v", got, want)
+	}
+	if res.Items["b"].Unknown != nil {
+		t.Errorf("items.b Unknown = %v, want nil", res.Items["b"].Unknown)
+	}
+}
+
+func TestDecodeUnknownFieldsDisabled(t *testing.T) {
+	app := NewApp("id", "key", "", "")
+	var res DiagnosisRes
+	err := app.decode(strings.NewReader(`{"new_top": true}`), &res, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Unknown != nil {
+		t.Errorf("Unknown = %v, want nil", res.Unknown)
+	}
+}
+
+func TestDecodeStrict(t *testing.T) {
+	app := NewApp("id", "key", "", "", WithStrictDecoding(true))
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "known fields", body: `{"conditions": [{"id": "c_1"}]}`},
+		{name: "nested unknown field", body: `{"conditions": [{"id": "c_1", "new_field": 1}]}`, wantErr: true},
+		{name: "top level unknown field", body: `{"new_top": 1}`, wantErr: true},
+		{name: "extras attributes", body: `{"extras": {"a": 1}, "question": {"extras": {"z": 1}}}`},
+		{name: "invalid json", body: `{"conditions": [`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res DiagnosisRes
+			err := app.decode(strings.NewReader(tt.body), &res, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decode error = %v, want error %v", err, tt.wantErr)
+			}
+			var decodeErr *DecodeError
+			if err != nil && !errors.As(err, &decodeErr) {
+				t.Errorf("decode error = %T, want *DecodeError", err)
+			}
+		})
+	}
+}
+
+func TestDecodeEnums(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		res      interface{}
+		wantPath string
+	}{
+		{name: "field", body: `{"triage_level": "new_level"}`, res: &TriageRes{}, wantPath: "triage_level"},
+		{name: "slice", body: `{"serious": [{"id": "s_1"}, {"id": "s_2", "seriousness": "new"}]}`, res: &TriageRes{}, wantPath: "serious[1].seriousness"},
+		{name: "map", body: `{"levels": {"a": "new_level"}}`, res: &decodeTestRes{}, wantPath: "levels.a"},
+		{name: "known values", body: `{"triage_level": "Emergency_Ambulance", "serious": [{"id": "s_1", "seriousness": "serious"}]}`, res: &TriageRes{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lenient := NewApp("id", "key", "", "")
+			v := reflect.New(reflect.TypeOf(tt.res).Elem()).Interface()
+			if err := lenient.decode(strings.NewReader(tt.body), v, nil); err != nil {
+				t.Fatalf("lenient decode error = %v", err)
+			}
+
+			strict := NewApp("id", "key", "", "", WithStrictDecoding(true))
+			err := strict.decode(strings.NewReader(tt.body), tt.res, nil)
+			if tt.wantPath == "" {
+				if err != nil {
+					t.Fatalf("strict decode error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "(at "+tt.wantPath+")") {
+				t.Errorf("strict decode error = %v, want an error at %s", err, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestDecodeKeepsUnknownEnumValues(t *testing.T) {
+	app := NewApp("id", "key", "", "")
+	var res TriageRes
+	err := app.decode(strings.NewReader(`{"triage_level": "new_level"}`), &res, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TriageLevel != "new_level" {
+		t.Errorf("TriageLevel = %q, want %q", res.TriageLevel, "new_level")
+	}
+	b, _ := json.Marshal(res.TriageLevel)
+	if string(b) != `"new_level"` {
+		t.Errorf("TriageLevel encodes as %s", b)
+	}
+}
@@ -28,28 +28,104 @@ type DiagnosisReqExtras struct {
 }
 
 type DiagnosisRes struct {
-	Question         Question         `json:"question"`
-	Conditions       []Conditions     `json:"conditions"`
-	ShouldStop       bool             `json:"should_stop"`
-	ConditionDetails ConditionDetails `json:"condition_details"` // Only enabled when IncludeConditionDetails is true
-	Extras           struct {
-	} `json:"extras"`
-	HasEmergencyEvidence bool `json:"has_emergency_evidence"`
+	Question             Question           `json:"question"`
+	Conditions           []Conditions       `json:"conditions"`
+	ShouldStop           bool               `json:"should_stop"`
+	ConditionDetails     ConditionDetails   `json:"condition_details"` // Deprecated: the API returns ConditionDetails on each item of Conditions
+	Extras               DiagnosisResExtras `json:"extras"`
+	HasEmergencyEvidence bool               `json:"has_emergency_evidence"`
+	InterviewToken       string             `json:"interview_token,omitempty"`
+	Unknown              Unknown            `json:"-"` // Only when WithUnknownFields is used
+}
+
+// DiagnosisResExtras is the extras object of the response. The v3 reference documents no attribute in it,
+// the data enabled by the request extras is returned in typed fields instead: ConditionDetails of each condition,
+// Explication and Instruction of each question item, EvidenceID of duration questions and third person Text.
+// Attributes found here are not documented and are kept as returned in Attributes, they are not reported in Unknown
+type DiagnosisResExtras struct {
+	Attributes ExtrasAttributes `json:"-"`
+}
+
+func (e DiagnosisResExtras) MarshalJSON() ([]byte, error) {
+	return e.Attributes.marshal()
+}
+
+func (e *DiagnosisResExtras) UnmarshalJSON(b []byte) error {
+	return e.Attributes.unmarshal(b)
 }
 
 type Question struct {
 	Type       QuestionType   `json:"type"`
-	Text       string         `json:"text"`
+	Text       string         `json:"text"`        // Third person when EnableThirdPersonQuestions is true
 	EvidenceID string         `json:"evidence_id"` // Only when questions of the type duration is enabled
 	Items      []QuestionItem `json:"items"`
-	Extras     struct {
-	} `json:"extras"`
+	Extras     QuestionExtras `json:"extras"`
+}
+
+// QuestionExtras is the extras object of a question. Like DiagnosisResExtras it has no documented attribute,
+// attributes found here are kept as returned
+type QuestionExtras struct {
+	Attributes ExtrasAttributes `json:"-"`
+}
+
+func (e QuestionExtras) MarshalJSON() ([]byte, error) {
+	return e.Attributes.marshal()
+}
+
+func (e *QuestionExtras) UnmarshalJSON(b []byte) error {
+	return e.Attributes.unmarshal(b)
+}
+
+// ExtrasAttributes are the undocumented attributes of an extras object, by name
+type ExtrasAttributes map[string]json.RawMessage
+
+// Decode decodes the attribute into v, ok is false when the attribute is missing
+func (x ExtrasAttributes) Decode(name string, v any) (ok bool, err error) {
+	raw, ok := x[name]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+// Map returns the attributes decoded as generic JSON values
+func (x ExtrasAttributes) Map() map[string]any {
+	m := make(map[string]any, len(x))
+	for k, raw := range x {
+		var v any
+		if json.Unmarshal(raw, &v) == nil {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+func (x ExtrasAttributes) marshal() ([]byte, error) {
+	if x == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(map[string]json.RawMessage(x))
+}
+
+func (x *ExtrasAttributes) unmarshal(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	if len(m) == 0 {
+		*x = nil
+		return nil
+	}
+	*x = m
+	return nil
 }
 
 type QuestionItem struct {
-	ID      string               `json:"id"`
-	Name    string               `json:"name"`
-	Choices []QuestionItemChoice `json:"choices"`
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Choices     []QuestionItemChoice `json:"choices"`
+	Explication string               `json:"explication,omitempty"` // Enabled only when EnableExplanations is true
+	Instruction []string             `json:"instruction,omitempty"` // Enabled only when EnableExplanations is true
 }
 
 type QuestionItemChoice struct {
@@ -58,10 +134,11 @@ type QuestionItemChoice struct {
 }
 
 type Conditions struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	CommonName  string  `json:"common_name"`
-	Probability float64 `json:"probability"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	CommonName       string            `json:"common_name"`
+	Probability      float64           `json:"probability"`
+	ConditionDetails *ConditionDetails `json:"condition_details,omitempty"` // Only enabled when IncludeConditionDetails is true
 }
 
 type ConditionDetails struct {
@@ -114,18 +191,24 @@ func QuestionTypeFromString(x string) (QuestionType, error) {
 	case "group_multiple":
 		return QuestionTypeGroupMultiple, nil
 	case "duration":
-		return QuestionTypeGroupMultiple, nil
+		return QuestionTypeDuration, nil
 	default:
 		return "", fmt.Errorf("infermedica: unexpected value for Question Type: %q", x)
 	}
 }
 
 // Diagnosis is a func to request diagnosis for given data
-func (a *App) Diagnosis(dr DiagnosisReq) (*DiagnosisRes, error) {
+func (a *App) Diagnosis(dr DiagnosisReq, opts ...RequestOption) (*DiagnosisRes, error) {
 	if dr.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: Unexpected value for Sex")
 	}
-	req, err := a.prepareRequest("POST", "diagnosis", dr)
+	if dr.EvaluatedAt != "" {
+		err := ValidateTimeline(dr.EvaluatedAt, dr.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "diagnosis", dr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +228,7 @@ func (a *App) Diagnosis(dr DiagnosisReq) (*DiagnosisRes, error) {
 	}
 
 	var r DiagnosisRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
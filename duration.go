@@ -0,0 +1,167 @@
+package infermedica
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func (du *DurationUnit) IsValid() error {
+	_, err := DurationUnitFromString(string(*du))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func DurationUnitFromString(x string) (DurationUnit, error) {
+	switch strings.ToLower(x) {
+	case "week", "weeks", "w":
+		return DurationUnitWeek, nil
+	case "day", "days", "d":
+		return DurationUnitDay, nil
+	case "hour", "hours", "h", "hr", "hrs":
+		return DurationUnitHour, nil
+	case "minute", "minutes", "min", "mins", "m":
+		return DurationUnitMinute, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for duration unit: %q", x)
+	}
+}
+
+// minutesPerUnit is ordered from the largest to the smallest unit
+var minutesPerUnit = []struct {
+	unit    DurationUnit
+	minutes int
+}{
+	{DurationUnitWeek, 7 * 24 * 60},
+	{DurationUnitDay, 24 * 60},
+	{DurationUnitHour, 60},
+	{DurationUnitMinute, 1},
+}
+
+// Minutes returns the duration in minutes
+func (d Duration) Minutes() int {
+	for _, u := range minutesPerUnit {
+		if u.unit == d.Unit {
+			return d.Value * u.minutes
+		}
+	}
+	return 0
+}
+
+// Normalize returns the same duration in the largest unit that represents it exactly, e.g. 14 days is 2 weeks
+func (d Duration) Normalize() Duration {
+	m := d.Minutes()
+	if m == 0 {
+		return d
+	}
+	for _, u := range minutesPerUnit {
+		if m%u.minutes == 0 {
+			return Duration{Value: m / u.minutes, Unit: u.unit}
+		}
+	}
+	return d
+}
+
+// IsValid returns an error when the unit is unknown or the value is not positive
+func (d *Duration) IsValid() error {
+	if err := d.Unit.IsValid(); err != nil {
+		return err
+	}
+	if d.Value <= 0 {
+		return fmt.Errorf("infermedica: duration must be positive: %d %s", d.Value, d.Unit)
+	}
+	return nil
+}
+
+// Fits returns an error when the duration is longer than the age of the patient
+func (d Duration) Fits(age Age) error {
+	if d.Minutes() > age.Months()*31*24*60 {
+		return fmt.Errorf("infermedica: duration of %d %s is longer than the age of the patient", d.Value, d.Unit)
+	}
+	return nil
+}
+
+var durationNumbers = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	"couple": 2, "few": 3, "several": 3,
+}
+
+var durationPhrases = map[string]Duration{
+	"yesterday":                {Value: 1, Unit: DurationUnitDay},
+	"last night":               {Value: 12, Unit: DurationUnitHour},
+	"this morning":             {Value: 6, Unit: DurationUnitHour},
+	"today":                    {Value: 6, Unit: DurationUnitHour},
+	"last week":                {Value: 1, Unit: DurationUnitWeek},
+	"the day before yesterday": {Value: 2, Unit: DurationUnitDay},
+}
+
+// ParseDuration converts a patient answer such as "3 days", "two weeks", "an hour", "for 30 min"
+// or "since yesterday" into a normalized Duration
+func ParseDuration(text string) (Duration, error) {
+	s := strings.ToLower(strings.TrimSpace(text))
+	s = strings.TrimSuffix(s, ".")
+	for _, prefix := range []string{"since ", "for ", "about ", "around ", "almost ", "over "} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	s = strings.TrimSuffix(s, " ago")
+	if d, ok := durationPhrases[s]; ok {
+		return d, nil
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 1 {
+		// Compact form, e.g. "3d" or "12h"
+		i := strings.IndexFunc(fields[0], func(r rune) bool { return r < '0' || r > '9' })
+		if i > 0 {
+			fields = []string{fields[0][:i], fields[0][i:]}
+		}
+	}
+	if len(fields) > 2 && (fields[0] == "a" || fields[0] == "the") {
+		fields = fields[1:]
+	}
+	if len(fields) == 3 && fields[1] == "of" {
+		// "a couple of days"
+		fields = []string{fields[0], fields[2]}
+	}
+	if len(fields) != 2 {
+		return Duration{}, fmt.Errorf("infermedica: can not parse duration: %q", text)
+	}
+	value, err := strconv.Atoi(fields[0])
+	if err != nil {
+		n, ok := durationNumbers[fields[0]]
+		if !ok {
+			return Duration{}, fmt.Errorf("infermedica: can not parse duration: %q", text)
+		}
+		value = n
+	}
+	unit, err := DurationUnitFromString(fields[1])
+	if err != nil {
+		return Duration{}, fmt.Errorf("infermedica: can not parse duration: %q", text)
+	}
+	d := Duration{Value: value, Unit: unit}
+	if err := d.IsValid(); err != nil {
+		return Duration{}, err
+	}
+	return d.Normalize(), nil
+}
+
+// AttachDuration sets the duration answered to a duration question on the evidence it refers to (Question.EvidenceID)
+func AttachDuration(evidences []Evidence, q Question, d Duration) error {
+	if q.Type != QuestionTypeDuration {
+		return fmt.Errorf("infermedica: question is not of the type duration: %q", q.Type)
+	}
+	if err := d.IsValid(); err != nil {
+		return err
+	}
+	for i := range evidences {
+		if evidences[i].ID == q.EvidenceID {
+			d := d.Normalize()
+			evidences[i].Duration = &d
+			return nil
+		}
+	}
+	return fmt.Errorf("infermedica: evidence of the duration question not found: %q", q.EvidenceID)
+}
@@ -0,0 +1,88 @@
+package infermedica
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// validator is implemented by the enums of the package
+type validator interface {
+	IsValid() error
+}
+
+// unmarshalEnum decodes a JSON string into an enum. Known values are normalized, unknown values
+// are kept as they are and reported when the response is decoded, so a new value added by the API
+// does not fail a request
+func unmarshalEnum[T ~string](b []byte, v *T, fromString func(string) (T, error)) error {
+	var s string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	x, err := fromString(s)
+	if err != nil {
+		*v = T(s)
+		return nil
+	}
+	*v = x
+	return nil
+}
+
+// validateEnums returns an error for every enum in v that holds a value the library does not know,
+// empty values are not reported because the API omits optional attributes
+func validateEnums(v reflect.Value, path string) []error {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if v.Len() == 0 || !v.CanAddr() {
+			return nil
+		}
+		if val, ok := v.Addr().Interface().(validator); ok {
+			if err := val.IsValid(); err != nil {
+				return []error{fmt.Errorf("%w (at %s)", err, path)}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var errs []error
+		for i := 0; i < v.Len(); i++ {
+			errs = append(errs, validateEnums(v.Index(i), path+"["+strconv.Itoa(i)+"]")...)
+		}
+		return errs
+	case reflect.Map:
+		var errs []error
+		iter := v.MapRange()
+		for iter.Next() {
+			// Map values are not addressable, the enums are validated on a copy
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+			errs = append(errs, validateEnums(elem, joinPath(path, fmt.Sprint(iter.Key().Interface())))...)
+		}
+		return errs
+	case reflect.Struct:
+		var errs []error
+		for i := 0; i < v.NumField(); i++ {
+			if name := jsonName(v.Type().Field(i)); name != "" {
+				errs = append(errs, validateEnums(v.Field(i), joinPath(path, name))...)
+			}
+		}
+		return errs
+	}
+	return nil
+}
+
+func compareRank(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package infermedica
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConflictRule decides which choice is kept when the same evidence is both present and absent
+type ConflictRule string
+
+const (
+	ConflictRulePreferPresent ConflictRule = "prefer_present" // Keep present, the safest choice for triage (default)
+	ConflictRulePreferAbsent  ConflictRule = "prefer_absent"  // Keep absent
+	ConflictRulePreferFirst   ConflictRule = "prefer_first"   // Keep the choice that was given first
+	ConflictRulePreferLast    ConflictRule = "prefer_last"    // Keep the choice that was given last, e.g. a later correction
+)
+
+func (cr *ConflictRule) IsValid() error {
+	_, err := ConflictRuleFromString(string(*cr))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func ConflictRuleFromString(x string) (ConflictRule, error) {
+	switch strings.ToLower(x) {
+	case "prefer_present":
+		return ConflictRulePreferPresent, nil
+	case "prefer_absent":
+		return ConflictRulePreferAbsent, nil
+	case "prefer_first":
+		return ConflictRulePreferFirst, nil
+	case "prefer_last":
+		return ConflictRulePreferLast, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for conflict rule: %q", x)
+	}
+}
+
+// resolve returns the evidence that is kept when first and last have the same ID.
+// An unknown choice never wins over present or absent
+func (cr ConflictRule) resolve(first, last Evidence) Evidence {
+	switch {
+	case first.ChoiceID == last.ChoiceID:
+		if first.Duration == nil {
+			first.Duration = last.Duration
+		}
+		return first
+	case last.ChoiceID == EvidenceChoiceIDUnknown:
+		return first
+	case first.ChoiceID == EvidenceChoiceIDUnknown:
+		return last
+	}
+	switch cr {
+	case ConflictRulePreferAbsent:
+		if first.ChoiceID == EvidenceChoiceIDAbsent {
+			return first
+		}
+		return last
+	case ConflictRulePreferFirst:
+		return first
+	case ConflictRulePreferLast:
+		return last
+	default:
+		if first.ChoiceID == EvidenceChoiceIDPresent {
+			return first
+		}
+		return last
+	}
+}
+
+// MergeEvidence adds evidence to an existing list, keeping one item per ID.
+// The order of existing is kept and new IDs are appended in the order they are given
+func MergeEvidence(existing, added []Evidence, rule ConflictRule) []Evidence {
+	merged := make([]Evidence, 0, len(existing)+len(added))
+	index := map[string]int{}
+	for _, list := range [][]Evidence{existing, added} {
+		for _, e := range list {
+			if i, ok := index[e.ID]; ok {
+				merged[i] = rule.resolve(merged[i], e)
+				continue
+			}
+			index[e.ID] = len(merged)
+			merged = append(merged, e)
+		}
+	}
+	return merged
+}
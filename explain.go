@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"net/http"
 	"time"
 )
@@ -29,11 +28,18 @@ type ExplainRes struct {
 	SupportingEvidence  []Observations `json:"supporting_evidence"`
 	ConflictingEvidence []Observations `json:"conflicting_evidence"`
 	UnconfirmedEvidence []Observations `json:"unconfirmed_evidence"`
+	Unknown             Unknown        `json:"-"` // Only when WithUnknownFields is used
 }
 
 // Explains which evidence impacts the probability of a selected condition appearing in the ranking
-func (a *App) Explain(er ExplainReq) (*ExplainRes, error) {
-	req, err := a.prepareRequest("POST", "explain", er)
+func (a *App) Explain(er ExplainReq, opts ...RequestOption) (*ExplainRes, error) {
+	if er.EvaluatedAt != "" && er.Evidences != nil {
+		err := ValidateTimeline(er.EvaluatedAt, *er.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "explain", er, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +58,7 @@ func (a *App) Explain(er ExplainReq) (*ExplainRes, error) {
 		return nil, err
 	}
 	var r ExplainRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,256 @@
+package fhir
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// ucum maps the duration units of the API to UCUM codes
+var ucum = map[infermedica.DurationUnit]string{
+	infermedica.DurationUnitWeek:   "wk",
+	infermedica.DurationUnitDay:    "d",
+	infermedica.DurationUnitHour:   "h",
+	infermedica.DurationUnitMinute: "min",
+}
+
+// ExportOptions changes the resources created by Export
+type ExportOptions struct {
+	MaxConditions int       // Number of conditions exported, all when zero
+	Timestamp     time.Time // Timestamp of the bundle, now when zero
+}
+
+// Export converts a finished interview to a collection Bundle with the Patient, an Observation for each evidence,
+// the age and the triage level, a Condition for each condition of the ranking and a RiskAssessment of the ranking.
+// Resources reference each other with urn:uuid URLs derived from the interview ID, so the same interview always
+// produces the same bundle
+func Export(r *infermedica.InterviewResult, o ExportOptions) (*Bundle, error) {
+	if r == nil {
+		return nil, fmt.Errorf("fhir: nil interview result")
+	}
+	if r.ID == "" {
+		return nil, fmt.Errorf("fhir: interview result without ID")
+	}
+	if err := r.Sex.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := r.Age.IsValid(); err != nil {
+		return nil, err
+	}
+	ts := o.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	b := &Bundle{
+		ResourceType: "Bundle",
+		ID:           newUUID(r.ID, "bundle"),
+		Type:         "collection",
+		Timestamp:    infermedica.FormatTime(ts),
+	}
+
+	patient := PatientFromResult(r)
+	subject := b.add(patient.ID, patient)
+
+	b.add(newUUID(r.ID, "age"), AgeObservation(r.Age, subject))
+
+	var basis []Reference
+	for _, e := range r.Evidence {
+		obs, err := ObservationFromEvidence(e, r.Name(e.ID), subject)
+		if err != nil {
+			return nil, err
+		}
+		basis = append(basis, b.add(newUUID(r.ID, "evidence", e.ID), obs))
+	}
+
+	if r.Triage != nil {
+		b.add(newUUID(r.ID, "triage"), TriageObservation(r.Triage, r.EvaluatedAt, subject))
+	}
+
+	if r.Diagnosis != nil {
+		conditions := r.Diagnosis.Conditions
+		if o.MaxConditions > 0 && len(conditions) > o.MaxConditions {
+			conditions = conditions[:o.MaxConditions]
+		}
+		for _, c := range conditions {
+			b.add(newUUID(r.ID, "condition", c.ID), ConditionFromResult(c, r.EvaluatedAt, subject))
+		}
+		ra := RiskAssessmentFromConditions(conditions, r.EvaluatedAt, subject, basis)
+		b.add(newUUID(r.ID, "ranking"), ra)
+	}
+
+	for _, entry := range b.Entry {
+		setID(entry)
+	}
+	return b, nil
+}
+
+// JSON encodes the bundle
+func (b *Bundle) JSON() ([]byte, error) {
+	return json.MarshalIndent(b, "", "  ")
+}
+
+// add appends a resource to the bundle and returns a reference to it
+func (b *Bundle) add(id string, resource interface{}) Reference {
+	url := "urn:uuid:" + id
+	b.Entry = append(b.Entry, BundleEntry{FullURL: url, Resource: resource})
+	return Reference{Reference: url}
+}
+
+// setID sets the id of the resource of the entry to its urn:uuid
+func setID(e BundleEntry) {
+	id := e.FullURL[len("urn:uuid:"):]
+	switch r := e.Resource.(type) {
+	case *Patient:
+		r.ID = id
+	case *Observation:
+		r.ID = id
+	case *Condition:
+		r.ID = id
+	case *RiskAssessment:
+		r.ID = id
+	}
+}
+
+// PatientFromResult returns the Patient of the interview, its ID is derived from the interview ID
+func PatientFromResult(r *infermedica.InterviewResult) *Patient {
+	return &Patient{
+		ResourceType: "Patient",
+		ID:           newUUID(r.ID, "patient"),
+		Gender:       string(r.Sex),
+	}
+}
+
+// AgeObservation returns the age of the patient as a LOINC 30525-0 Observation
+func AgeObservation(age infermedica.Age, subject Reference) *Observation {
+	q := &Quantity{Value: float64(age.Years()), Unit: "years", System: SystemUCUM, Code: "a"}
+	if age.Unit == infermedica.AgeUnitMonth {
+		q = &Quantity{Value: float64(age.Months()), Unit: "months", System: SystemUCUM, Code: "mo"}
+	}
+	return &Observation{
+		ResourceType:  "Observation",
+		Status:        "final",
+		Code:          CodeableConcept{Coding: []Coding{{System: SystemLOINC, Code: "30525-0", Display: "Age"}}},
+		Subject:       &subject,
+		ValueQuantity: q,
+	}
+}
+
+// ObservationFromEvidence converts an evidence to an Observation coded with the Infermedica ID,
+// present and absent are a valueBoolean and unknown a dataAbsentReason
+func ObservationFromEvidence(e infermedica.Evidence, name string, subject Reference) (*Observation, error) {
+	obs := &Observation{
+		ResourceType: "Observation",
+		Status:       "final",
+		Category:     []CodeableConcept{{Coding: []Coding{{System: SystemObsCategory, Code: "survey"}}}},
+		Code:         CodeableConcept{Coding: []Coding{{System: SystemInfermedica, Code: e.ID, Display: name}}, Text: name},
+		Subject:      &subject,
+	}
+	switch e.ChoiceID {
+	case infermedica.EvidenceChoiceIDPresent, infermedica.EvidenceChoiceIDAbsent:
+		v := e.ChoiceID == infermedica.EvidenceChoiceIDPresent
+		obs.ValueBoolean = &v
+	case infermedica.EvidenceChoiceIDUnknown:
+		obs.DataAbsentReason = &CodeableConcept{Coding: []Coding{{System: SystemAbsentReason, Code: "asked-unknown"}}}
+	default:
+		return nil, fmt.Errorf("fhir: unexpected choice %q for evidence %s", e.ChoiceID, e.ID)
+	}
+	if e.Source != "" {
+		obs.Extension = []Extension{{URL: ExtensionEvidenceSource, ValueCode: string(e.Source)}}
+	}
+	if _, ok, err := e.ObservedTime(); err != nil {
+		return nil, err
+	} else if ok {
+		obs.EffectiveDateTime = e.ObservedAt
+	}
+	if e.Duration != nil {
+		code, ok := ucum[e.Duration.Unit]
+		if !ok {
+			return nil, fmt.Errorf("fhir: unexpected duration unit %q for evidence %s", e.Duration.Unit, e.ID)
+		}
+		obs.Component = []ObservationComponent{{
+			Code:          CodeableConcept{Coding: []Coding{{System: SystemInfermedica, Code: "duration"}}, Text: "Duration"},
+			ValueQuantity: &Quantity{Value: float64(e.Duration.Value), Unit: string(e.Duration.Unit), System: SystemUCUM, Code: code},
+		}}
+	}
+	return obs, nil
+}
+
+// TriageObservation converts a triage result to an Observation with the triage level,
+// the serious observations are listed as components
+func TriageObservation(t *infermedica.TriageRes, evaluatedAt string, subject Reference) *Observation {
+	obs := &Observation{
+		ResourceType:         "Observation",
+		Status:               "final",
+		Code:                 CodeableConcept{Coding: []Coding{{System: SystemTriageLevel, Code: "triage_level"}}, Text: "Triage level"},
+		Subject:              &subject,
+		EffectiveDateTime:    evaluatedAt,
+		ValueCodeableConcept: &CodeableConcept{Coding: []Coding{{System: SystemTriageLevel, Code: string(t.TriageLevel)}}, Text: t.TriageLevel.String()},
+	}
+	for _, s := range t.Serious {
+		obs.Component = append(obs.Component, ObservationComponent{
+			Code:                 CodeableConcept{Coding: []Coding{{System: SystemInfermedica, Code: s.ID, Display: s.CommonName}}},
+			ValueCodeableConcept: &CodeableConcept{Coding: []Coding{{System: SystemTriageLevel, Code: string(s.Seriousness)}}},
+		})
+	}
+	return obs
+}
+
+// ConditionFromResult converts a condition of the ranking to a provisional Condition, coded with the Infermedica
+// ID and, when ConditionDetails is included, the ICD-10 code. The probability is kept as an extension
+func ConditionFromResult(c infermedica.Conditions, evaluatedAt string, subject Reference) *Condition {
+	p := c.Probability
+	cond := &Condition{
+		ResourceType:       "Condition",
+		Extension:          []Extension{{URL: ExtensionProbability, ValueDecimal: &p}},
+		VerificationStatus: &CodeableConcept{Coding: []Coding{{System: SystemVerification, Code: "provisional"}}},
+		Code:               conditionCode(c),
+		Subject:            subject,
+		RecordedDate:       evaluatedAt,
+	}
+	if c.ConditionDetails != nil && c.ConditionDetails.Hint != "" {
+		cond.Note = []Annotation{{Text: c.ConditionDetails.Hint}}
+	}
+	return cond
+}
+
+// RiskAssessmentFromConditions converts the ranking to a RiskAssessment with a prediction for each condition
+func RiskAssessmentFromConditions(conditions []infermedica.Conditions, evaluatedAt string, subject Reference, basis []Reference) *RiskAssessment {
+	ra := &RiskAssessment{
+		ResourceType:       "RiskAssessment",
+		Status:             "final",
+		Method:             &CodeableConcept{Coding: []Coding{{System: SystemInfermedica, Code: "diagnosis"}}, Text: "Infermedica diagnosis"},
+		Subject:            subject,
+		OccurrenceDateTime: evaluatedAt,
+		Basis:              basis,
+	}
+	for _, c := range conditions {
+		p := c.Probability
+		code := conditionCode(c)
+		ra.Prediction = append(ra.Prediction, RiskPrediction{Outcome: &code, ProbabilityDecimal: &p})
+	}
+	return ra
+}
+
+func conditionCode(c infermedica.Conditions) CodeableConcept {
+	cc := CodeableConcept{Coding: []Coding{{System: SystemInfermedica, Code: c.ID, Display: c.Name}}, Text: c.CommonName}
+	if c.ConditionDetails != nil && c.ConditionDetails.Icd10Code != "" {
+		cc.Coding = append(cc.Coding, Coding{System: SystemICD10, Code: c.ConditionDetails.Icd10Code, Display: c.Name})
+	}
+	return cc
+}
+
+// newUUID returns a name-based (version 5 layout) UUID for the given parts
+func newUUID(parts ...string) string {
+	h := sha1.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	u := h.Sum(nil)[:16]
+	u[6] = u[6]&0x0f | 0x50
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
@@ -0,0 +1,139 @@
+package fhir
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// ItemMapping maps an item of a questionnaire to evidence
+type ItemMapping struct {
+	ID      string                                  // Evidence of a yes/no item
+	Choices map[string]infermedica.EvidenceChoiceID // Choice of the evidence for each answer code or string, when not yes/no
+	Codes   map[string]string                       // Evidence present for each answer code or string of a multiple choice item
+}
+
+// Mapping maps items of a questionnaire by linkId. Items that are not in the mapping are imported
+// when their linkId is an Infermedica ID or their answers are coded with SystemInfermedica
+type Mapping map[string]ItemMapping
+
+// yes, no and unknown answers of yes/no items, as codes of the HL7 yes/no and data absent code systems or strings
+var answerChoices = map[string]infermedica.EvidenceChoiceID{
+	"y":             infermedica.EvidenceChoiceIDPresent,
+	"yes":           infermedica.EvidenceChoiceIDPresent,
+	"true":          infermedica.EvidenceChoiceIDPresent,
+	"present":       infermedica.EvidenceChoiceIDPresent,
+	"n":             infermedica.EvidenceChoiceIDAbsent,
+	"no":            infermedica.EvidenceChoiceIDAbsent,
+	"false":         infermedica.EvidenceChoiceIDAbsent,
+	"absent":        infermedica.EvidenceChoiceIDAbsent,
+	"unk":           infermedica.EvidenceChoiceIDUnknown,
+	"unknown":       infermedica.EvidenceChoiceIDUnknown,
+	"asked-unknown": infermedica.EvidenceChoiceIDUnknown,
+	"dont_know":     infermedica.EvidenceChoiceIDUnknown,
+}
+
+// ParseQuestionnaireResponse decodes a QuestionnaireResponse resource
+func ParseQuestionnaireResponse(data []byte) (*QuestionnaireResponse, error) {
+	var qr QuestionnaireResponse
+	if err := json.Unmarshal(data, &qr); err != nil {
+		return nil, err
+	}
+	if qr.ResourceType != "QuestionnaireResponse" {
+		return nil, fmt.Errorf("fhir: unexpected resource type %q", qr.ResourceType)
+	}
+	return &qr, nil
+}
+
+// ImportEvidence converts the answers of a QuestionnaireResponse to initial evidence, later answers about the
+// same evidence replace earlier ones. Items without answers are skipped, answers that can not be mapped
+// are all reported in the returned error
+func ImportEvidence(qr *QuestionnaireResponse, m Mapping) ([]infermedica.Evidence, error) {
+	if qr == nil {
+		return nil, fmt.Errorf("fhir: nil questionnaire response")
+	}
+	if qr.Status == "entered-in-error" {
+		return nil, fmt.Errorf("fhir: questionnaire response with status %q", qr.Status)
+	}
+	var evidences []infermedica.Evidence
+	var errs []error
+	var walk func(items []QuestionnaireResponseItem)
+	walk = func(items []QuestionnaireResponseItem) {
+		for _, item := range items {
+			for _, a := range item.Answer {
+				e, err := m.evidence(item, a)
+				if err != nil {
+					errs = append(errs, err)
+				}
+				evidences = append(evidences, e...)
+				walk(a.Item)
+			}
+			walk(item.Item)
+		}
+	}
+	walk(qr.Item)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	for i := range evidences {
+		evidences[i].Source = infermedica.EvidenceSourceInitial
+	}
+	return infermedica.MergeEvidence(nil, evidences, infermedica.ConflictRulePreferLast), nil
+}
+
+// evidence maps one answer of an item
+func (m Mapping) evidence(item QuestionnaireResponseItem, a QuestionnaireResponseAnswer) ([]infermedica.Evidence, error) {
+	im, mapped := m[item.LinkID]
+	if !mapped && isInfermedicaID(item.LinkID) {
+		im = ItemMapping{ID: item.LinkID}
+	}
+	value := a.ValueString
+	if a.ValueCoding != nil {
+		value = a.ValueCoding.Code
+		if a.ValueCoding.System == SystemInfermedica && im.Codes == nil {
+			if !isInfermedicaID(value) {
+				return nil, fmt.Errorf("fhir: item %s: unexpected Infermedica code %q", item.LinkID, value)
+			}
+			return []infermedica.Evidence{{ID: value, ChoiceID: infermedica.EvidenceChoiceIDPresent}}, nil
+		}
+	}
+	if a.ValueBoolean != nil {
+		value = fmt.Sprint(*a.ValueBoolean)
+	}
+	if id, ok := im.Codes[value]; ok {
+		return []infermedica.Evidence{{ID: id, ChoiceID: infermedica.EvidenceChoiceIDPresent}}, nil
+	}
+	if im.ID == "" {
+		return nil, fmt.Errorf("fhir: item %s: unmapped answer %q", item.LinkID, value)
+	}
+	choice, ok := im.Choices[value]
+	if !ok {
+		choice, ok = answerChoices[strings.ToLower(value)]
+	}
+	if !ok {
+		return nil, fmt.Errorf("fhir: item %s: unmapped answer %q for %s", item.LinkID, value, im.ID)
+	}
+	return []infermedica.Evidence{{ID: im.ID, ChoiceID: choice}}, nil
+}
+
+// isInfermedicaID reports whether id looks like an observation ID, e.g. s_21 or p_7
+func isInfermedicaID(id string) bool {
+	prefix, n, ok := strings.Cut(id, "_")
+	if !ok || n == "" {
+		return false
+	}
+	switch prefix {
+	case "s", "p", "lt", "e":
+	default:
+		return false
+	}
+	for _, r := range n {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+// Package fhir converts interviews to and from FHIR R4 resources.
+//
+// Only the elements used by the conversions are modelled, resources are encoded with encoding/json.
+package fhir
+
+const (
+	SystemInfermedica  = "https://api.infermedica.com"              // Code system of the Infermedica observation and condition IDs
+	SystemTriageLevel  = "https://api.infermedica.com/triage-level" // Code system of the Infermedica triage levels
+	SystemICD10        = "http://hl7.org/fhir/sid/icd-10"           // ICD-10 code system
+	SystemLOINC        = "http://loinc.org"                         // LOINC code system
+	SystemUCUM         = "http://unitsofmeasure.org"                // UCUM units
+	SystemObsCategory  = "http://terminology.hl7.org/CodeSystem/observation-category"
+	SystemVerification = "http://terminology.hl7.org/CodeSystem/condition-ver-status"
+	SystemAbsentReason = "http://terminology.hl7.org/CodeSystem/data-absent-reason"
+
+	// ExtensionProbability is the extension of a Condition with the probability given by Diagnosis
+	ExtensionProbability = "https://github.com/guiarnaldo/infermedica-v3/fhir/StructureDefinition/condition-probability"
+	// ExtensionEvidenceSource is the extension of an Observation with the source of the evidence
+	ExtensionEvidenceSource = "https://github.com/guiarnaldo/infermedica-v3/fhir/StructureDefinition/evidence-source"
+)
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+type Quantity struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	System string  `json:"system,omitempty"`
+	Code   string  `json:"code,omitempty"`
+}
+
+type Extension struct {
+	URL          string   `json:"url"`
+	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueCode    string   `json:"valueCode,omitempty"`
+	ValueString  string   `json:"valueString,omitempty"`
+}
+
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+type Patient struct {
+	ResourceType string `json:"resourceType"`
+	ID           string `json:"id,omitempty"`
+	Gender       string `json:"gender,omitempty"`
+}
+
+type Observation struct {
+	ResourceType         string                 `json:"resourceType"`
+	ID                   string                 `json:"id,omitempty"`
+	Extension            []Extension            `json:"extension,omitempty"`
+	Status               string                 `json:"status"`
+	Category             []CodeableConcept      `json:"category,omitempty"`
+	Code                 CodeableConcept        `json:"code"`
+	Subject              *Reference             `json:"subject,omitempty"`
+	EffectiveDateTime    string                 `json:"effectiveDateTime,omitempty"`
+	ValueBoolean         *bool                  `json:"valueBoolean,omitempty"`
+	ValueQuantity        *Quantity              `json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *CodeableConcept       `json:"valueCodeableConcept,omitempty"`
+	DataAbsentReason     *CodeableConcept       `json:"dataAbsentReason,omitempty"`
+	HasMember            []Reference            `json:"hasMember,omitempty"`
+	Component            []ObservationComponent `json:"component,omitempty"`
+}
+
+type ObservationComponent struct {
+	Code                 CodeableConcept  `json:"code"`
+	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty"`
+}
+
+type Condition struct {
+	ResourceType       string           `json:"resourceType"`
+	ID                 string           `json:"id,omitempty"`
+	Extension          []Extension      `json:"extension,omitempty"`
+	VerificationStatus *CodeableConcept `json:"verificationStatus,omitempty"`
+	Code               CodeableConcept  `json:"code"`
+	Subject            Reference        `json:"subject"`
+	RecordedDate       string           `json:"recordedDate,omitempty"`
+	Note               []Annotation     `json:"note,omitempty"`
+}
+
+type RiskAssessment struct {
+	ResourceType       string           `json:"resourceType"`
+	ID                 string           `json:"id,omitempty"`
+	Status             string           `json:"status"`
+	Method             *CodeableConcept `json:"method,omitempty"`
+	Subject            Reference        `json:"subject"`
+	OccurrenceDateTime string           `json:"occurrenceDateTime,omitempty"`
+	Basis              []Reference      `json:"basis,omitempty"`
+	Prediction         []RiskPrediction `json:"prediction,omitempty"`
+}
+
+type RiskPrediction struct {
+	Outcome            *CodeableConcept `json:"outcome,omitempty"`
+	ProbabilityDecimal *float64         `json:"probabilityDecimal,omitempty"`
+	QualitativeRisk    *CodeableConcept `json:"qualitativeRisk,omitempty"`
+}
+
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	ID           string        `json:"id,omitempty"`
+	Type         string        `json:"type"`
+	Timestamp    string        `json:"timestamp,omitempty"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+type BundleEntry struct {
+	FullURL  string      `json:"fullUrl,omitempty"`
+	Resource interface{} `json:"resource"`
+}
+
+type QuestionnaireResponse struct {
+	ResourceType  string                      `json:"resourceType"`
+	ID            string                      `json:"id,omitempty"`
+	Questionnaire string                      `json:"questionnaire,omitempty"`
+	Status        string                      `json:"status"`
+	Subject       *Reference                  `json:"subject,omitempty"`
+	Authored      string                      `json:"authored,omitempty"`
+	Item          []QuestionnaireResponseItem `json:"item,omitempty"`
+}
+
+type QuestionnaireResponseItem struct {
+	LinkID string                        `json:"linkId"`
+	Text   string                        `json:"text,omitempty"`
+	Answer []QuestionnaireResponseAnswer `json:"answer,omitempty"`
+	Item   []QuestionnaireResponseItem   `json:"item,omitempty"`
+}
+
+type QuestionnaireResponseAnswer struct {
+	ValueBoolean *bool                       `json:"valueBoolean,omitempty"`
+	ValueString  string                      `json:"valueString,omitempty"`
+	ValueCoding  *Coding                     `json:"valueCoding,omitempty"`
+	Item         []QuestionnaireResponseItem `json:"item,omitempty"`
+}
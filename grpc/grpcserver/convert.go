@@ -0,0 +1,295 @@
+package grpcserver
+
+import (
+	"github.com/guiarnaldo/infermedica-v3"
+	pb "github.com/guiarnaldo/infermedica-v3/grpc/infermedicav1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// AgeFromProto converts a proto Age
+func AgeFromProto(a *pb.Age) infermedica.Age {
+	return infermedica.Age{
+		Value: int(a.GetValue()),
+		Unit:  infermedica.AgeUnit(a.GetUnit()),
+	}
+}
+
+// AgeToProto converts an Age
+func AgeToProto(a infermedica.Age) *pb.Age {
+	return &pb.Age{
+		Value: int32(a.Value),
+		Unit:  string(a.Unit),
+	}
+}
+
+// EvidenceFromProto converts proto evidence
+func EvidenceFromProto(evidence []*pb.Evidence) []infermedica.Evidence {
+	if evidence == nil {
+		return nil
+	}
+	r := make([]infermedica.Evidence, len(evidence))
+	for i, e := range evidence {
+		r[i] = infermedica.Evidence{
+			ID:         e.GetId(),
+			ChoiceID:   infermedica.EvidenceChoiceID(e.GetChoiceId()),
+			ObservedAt: e.GetObservedAt(),
+			Source:     infermedica.EvidenceSource(e.GetSource()),
+		}
+		if d := e.GetDuration(); d != nil {
+			r[i].Duration = &infermedica.Duration{
+				Value: int(d.GetValue()),
+				Unit:  infermedica.DurationUnit(d.GetUnit()),
+			}
+		}
+	}
+	return r
+}
+
+// EvidenceToProto converts evidence
+func EvidenceToProto(evidence []infermedica.Evidence) []*pb.Evidence {
+	if evidence == nil {
+		return nil
+	}
+	r := make([]*pb.Evidence, len(evidence))
+	for i, e := range evidence {
+		r[i] = &pb.Evidence{
+			Id:         e.ID,
+			ChoiceId:   string(e.ChoiceID),
+			ObservedAt: e.ObservedAt,
+			Source:     string(e.Source),
+		}
+		if e.Duration != nil {
+			r[i].Duration = &pb.Duration{
+				Value: int32(e.Duration.Value),
+				Unit:  string(e.Duration.Unit),
+			}
+		}
+	}
+	return r
+}
+
+// ParseReqFromProto converts a proto ParseRequest
+func ParseReqFromProto(r *pb.ParseRequest) infermedica.ParseReq {
+	return infermedica.ParseReq{
+		Text:            r.GetText(),
+		Age:             AgeFromProto(r.GetAge()),
+		Sex:             infermedica.Sex(r.GetSex()),
+		CorrectSpelling: r.GetCorrectSpelling(),
+		IncludeTokens:   r.GetIncludeTokens(),
+	}
+}
+
+// ParseResToProto converts a ParseRes
+func ParseResToProto(r *infermedica.ParseRes) *pb.ParseResponse {
+	res := &pb.ParseResponse{
+		Tokens:  r.Tokens,
+		Obvious: r.Obvious,
+	}
+	for _, m := range r.Mentions {
+		pm := &pb.Mention{
+			Id:         m.ID,
+			Orth:       m.Orth,
+			ChoiceId:   string(m.ChoiceID),
+			Name:       m.Name,
+			CommonName: m.CommonName,
+			Type:       string(m.Type),
+		}
+		for _, p := range m.Positions {
+			pm.Positions = append(pm.Positions, int32(p))
+		}
+		if m.HeadPosition != nil {
+			h := int32(*m.HeadPosition)
+			pm.HeadPosition = &h
+		}
+		res.Mentions = append(res.Mentions, pm)
+	}
+	return res
+}
+
+// DiagnosisReqFromProto converts a proto DiagnosisRequest
+func DiagnosisReqFromProto(r *pb.DiagnosisRequest) infermedica.DiagnosisReq {
+	req := infermedica.DiagnosisReq{
+		Sex:         infermedica.Sex(r.GetSex()),
+		Age:         AgeFromProto(r.GetAge()),
+		EvaluatedAt: r.GetEvaluatedAt(),
+		Evidences:   EvidenceFromProto(r.GetEvidence()),
+	}
+	if e := r.GetExtras(); e != nil {
+		req.Extras = &infermedica.DiagnosisReqExtras{
+			EnableTriage3:              e.GetEnableTriage_3(),
+			InterviewMode:              infermedica.InterviewMode(e.GetInterviewMode()),
+			DisableAdaptiveRanking:     e.GetDisableAdaptiveRanking(),
+			EnableExplanations:         e.GetEnableExplanations(),
+			EnableThirdPersonQuestions: e.GetEnableThirdPersonQuestions(),
+			IncludeConditionDetails:    e.GetIncludeConditionDetails(),
+			DisableIntimateContent:     e.GetDisableIntimateContent(),
+			EnableSymptomDuration:      e.GetEnableSymptomDuration(),
+		}
+	}
+	return req
+}
+
+// DiagnosisResToProto converts a DiagnosisRes, extras that can not be represented as a Struct are dropped
+func DiagnosisResToProto(r *infermedica.DiagnosisRes) *pb.DiagnosisResponse {
+	res := &pb.DiagnosisResponse{
+		Question:             QuestionToProto(r.Question),
+		ShouldStop:           r.ShouldStop,
+		Extras:               toStruct(r.Extras.Attributes.Map()),
+		HasEmergencyEvidence: r.HasEmergencyEvidence,
+		InterviewToken:       r.InterviewToken,
+	}
+	for _, c := range r.Conditions {
+		pc := &pb.Condition{
+			Id:          c.ID,
+			Name:        c.Name,
+			CommonName:  c.CommonName,
+			Probability: c.Probability,
+		}
+		if d := c.ConditionDetails; d != nil {
+			pc.ConditionDetails = &pb.ConditionDetails{
+				Icd10Code:           d.Icd10Code,
+				Category:            &pb.ConditionCategory{Id: d.Category.ID, Name: d.Category.Name},
+				Prevalence:          string(d.Prevalence),
+				Severity:            string(d.Severity),
+				Acuteness:           string(d.Acuteness),
+				TriageLevel:         string(d.TriageLevel),
+				Hint:                d.Hint,
+				HasPatientEducation: d.HasPatientEducation,
+			}
+		}
+		res.Conditions = append(res.Conditions, pc)
+	}
+	return res
+}
+
+// QuestionToProto converts a Question
+func QuestionToProto(q infermedica.Question) *pb.Question {
+	pq := &pb.Question{
+		Type:       string(q.Type),
+		Text:       q.Text,
+		EvidenceId: q.EvidenceID,
+		Extras:     toStruct(q.Extras.Attributes.Map()),
+	}
+	for _, item := range q.Items {
+		pi := &pb.QuestionItem{
+			Id:          item.ID,
+			Name:        item.Name,
+			Explication: item.Explication,
+			Instruction: item.Instruction,
+		}
+		for _, c := range item.Choices {
+			pi.Choices = append(pi.Choices, &pb.QuestionItemChoice{Id: string(c.ID), Label: c.Label})
+		}
+		pq.Items = append(pq.Items, pi)
+	}
+	return pq
+}
+
+// TriageReqFromProto converts a proto TriageRequest
+func TriageReqFromProto(r *pb.TriageRequest) infermedica.TriageReq {
+	req := infermedica.TriageReq{
+		Sex:         infermedica.Sex(r.GetSex()),
+		Age:         AgeFromProto(r.GetAge()),
+		Evidences:   EvidenceFromProto(r.GetEvidence()),
+		EvaluatedAt: r.GetEvaluatedAt(),
+	}
+	if e := r.GetExtras(); e != nil {
+		req.Extras = &infermedica.TriageReqExtras{
+			EnableTriage3:         e.GetEnableTriage_3(),
+			EnableSymptomDuration: e.GetEnableSymptomDuration(),
+		}
+	}
+	return req
+}
+
+// TriageResToProto converts a TriageRes
+func TriageResToProto(r *infermedica.TriageRes) *pb.TriageResponse {
+	res := &pb.TriageResponse{
+		TriageLevel:                string(r.TriageLevel),
+		TeleconsultationApplicable: r.TeleconsultationApplicable,
+		RootCause:                  string(r.RootCause),
+	}
+	for _, s := range r.Serious {
+		res.Serious = append(res.Serious, &pb.Serious{
+			Id:          s.ID,
+			Name:        s.Name,
+			CommonName:  s.CommonName,
+			Seriousness: string(s.Seriousness),
+			IsEmergency: s.IsEmergency,
+		})
+	}
+	return res
+}
+
+// SuggestReqFromProto converts a proto SuggestRequest
+func SuggestReqFromProto(r *pb.SuggestRequest) infermedica.SuggestReq {
+	req := infermedica.SuggestReq{
+		Sex:           infermedica.Sex(r.GetSex()),
+		Age:           AgeFromProto(r.GetAge()),
+		EvaluatedAt:   r.GetEvaluatedAt(),
+		Evidences:     EvidenceFromProto(r.GetEvidence()),
+		SuggestMethod: infermedica.SuggestMethod(r.GetSuggestMethod()),
+		MaxResults:    int(r.GetMaxResults()),
+	}
+	if e := r.GetExtras(); e != nil {
+		req.Extras = &infermedica.SuggestExtras{
+			EnableExplanations:    e.GetEnableExplanations(),
+			EnableSymptomDuration: e.GetEnableSymptomDuration(),
+		}
+	}
+	return req
+}
+
+// SuggestResToProto converts the suggestions
+func SuggestResToProto(r []infermedica.SuggestRes) *pb.SuggestResponse {
+	res := &pb.SuggestResponse{}
+	for _, s := range r {
+		res.Suggestions = append(res.Suggestions, &pb.Suggestion{
+			Id:          s.ID,
+			Name:        s.Name,
+			CommonName:  s.CommonName,
+			Explication: s.Explication,
+			Instruction: s.Instruction,
+		})
+	}
+	return res
+}
+
+// RecommendSpecialistReqFromProto converts a proto RecommendSpecialistRequest
+func RecommendSpecialistReqFromProto(r *pb.RecommendSpecialistRequest) infermedica.RecommendSpecialistReq {
+	req := infermedica.RecommendSpecialistReq{
+		Sex:         infermedica.Sex(r.GetSex()),
+		Age:         AgeFromProto(r.GetAge()),
+		EvaluatedAt: r.GetEvaluatedAt(),
+		Evidences:   EvidenceFromProto(r.GetEvidence()),
+	}
+	if e := r.GetExtras(); e != nil {
+		req.Extras = &infermedica.RecommendSpecialistReqExtras{
+			EnableSymptomDuration: e.GetEnableSymptomDuration(),
+			SpecialistMapping:     e.GetSpecialistMapping(),
+		}
+	}
+	return req
+}
+
+// RecommendSpecialistResToProto converts a RecommendSpecialistRes
+func RecommendSpecialistResToProto(r *infermedica.RecommendSpecialistRes) *pb.RecommendSpecialistResponse {
+	return &pb.RecommendSpecialistResponse{
+		RecommendedSpecialist: &pb.Specialist{
+			Id:   r.RecommendedSpecialist.Id,
+			Name: r.RecommendedSpecialist.Name,
+		},
+		RecommendedChannel: string(r.RecommendedChannel),
+	}
+}
+
+func toStruct(m map[string]any) *structpb.Struct {
+	if len(m) == 0 {
+		return nil
+	}
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		return nil
+	}
+	return s
+}
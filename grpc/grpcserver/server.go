@@ -0,0 +1,143 @@
+// Package grpcserver implements the infermedica.v1 gRPC service with an App.
+package grpcserver
+
+//go:generate protoc -I ../proto --go_out=../infermedicav1 --go_opt=paths=source_relative --go-grpc_out=../infermedicav1 --go-grpc_opt=paths=source_relative infermedica/v1/infermedica.proto
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/guiarnaldo/infermedica-v3"
+	pb "github.com/guiarnaldo/infermedica-v3/grpc/infermedicav1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server is the infermedica.v1 Infermedica service backed by an App
+type Server struct {
+	pb.UnimplementedInfermedicaServer
+	app *infermedica.App
+}
+
+// New returns a Server that calls the API with app, register it with pb.RegisterInfermedicaServer
+func New(app *infermedica.App) *Server {
+	return &Server{app: app}
+}
+
+func (s *Server) Parse(ctx context.Context, r *pb.ParseRequest) (*pb.ParseResponse, error) {
+	res, err := s.app.Parse(ParseReqFromProto(r), options(ctx)...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return ParseResToProto(res), nil
+}
+
+func (s *Server) Diagnosis(ctx context.Context, r *pb.DiagnosisRequest) (*pb.DiagnosisResponse, error) {
+	res, err := s.app.Diagnosis(DiagnosisReqFromProto(r), options(ctx)...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return DiagnosisResToProto(res), nil
+}
+
+func (s *Server) Triage(ctx context.Context, r *pb.TriageRequest) (*pb.TriageResponse, error) {
+	res, err := s.app.Triage(TriageReqFromProto(r), options(ctx)...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return TriageResToProto(res), nil
+}
+
+func (s *Server) Suggest(ctx context.Context, r *pb.SuggestRequest) (*pb.SuggestResponse, error) {
+	res, err := s.app.Suggest(SuggestReqFromProto(r), options(ctx)...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return SuggestResToProto(*res), nil
+}
+
+func (s *Server) RecommendSpecialist(ctx context.Context, r *pb.RecommendSpecialistRequest) (*pb.RecommendSpecialistResponse, error) {
+	res, err := s.app.RecommendSpecialist(RecommendSpecialistReqFromProto(r), options(ctx)...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return RecommendSpecialistResToProto(res), nil
+}
+
+// options returns the request options set in the incoming metadata, the request is bound to ctx so the call
+// is canceled with the RPC and its deadline
+func options(ctx context.Context) []infermedica.RequestOption {
+	opts := []infermedica.RequestOption{infermedica.WithContext(ctx)}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return opts
+	}
+	if v := md.Get("interview-id"); len(v) > 0 {
+		opts = append(opts, infermedica.WithInterviewID(v[0]))
+	}
+	if v := md.Get("language"); len(v) > 0 {
+		opts = append(opts, infermedica.WithLanguage(infermedica.Language(v[0])))
+	}
+	if v := md.Get("model"); len(v) > 0 {
+		opts = append(opts, infermedica.WithModel(infermedica.Model(v[0])))
+	}
+	if v := md.Get("dev-mode"); len(v) > 0 {
+		if devMode, err := strconv.ParseBool(v[0]); err == nil {
+			opts = append(opts, infermedica.WithDevMode(devMode))
+		}
+	}
+	return opts
+}
+
+// toStatus maps the errors of the client to gRPC codes: errors returned by the API keep the meaning of their
+// HTTP status, transport failures are Unavailable, undecodable responses are Internal and every other error
+// comes from the validation of the request before it is sent, so it is InvalidArgument and must not be retried
+func toStatus(err error) error {
+	var apiErr *infermedica.APIError
+	var decodeErr *infermedica.DecodeError
+	var urlErr *url.Error
+	switch {
+	case errors.As(err, &apiErr):
+		return status.Error(httpCode(apiErr.StatusCode), err.Error())
+	case errors.As(err, &decodeErr):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.As(err, &urlErr):
+		if urlErr.Timeout() {
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// httpCode maps an HTTP status of the API to a gRPC code
+func httpCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return codes.Unavailable
+	}
+	if statusCode >= 500 {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,2191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: infermedica/v1/infermedica.proto
+
+// Package infermedica.v1 mirrors the request and response types of the Go client.
+// Enumerations are strings with the values of the Infermedica API, so values added
+// by the API are passed through unchanged.
+//
+// The headers of a call are set with gRPC metadata: "interview-id", "model", "language" and "dev-mode".
+
+package infermedicav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Age struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"` // year (default) or month
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Age) Reset() {
+	*x = Age{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Age) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Age) ProtoMessage() {}
+
+func (x *Age) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Age.ProtoReflect.Descriptor instead.
+func (*Age) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Age) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Age) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type Duration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"` // week, day, hour or minute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Duration) Reset() {
+	*x = Duration{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Duration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Duration) ProtoMessage() {}
+
+func (x *Duration) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Duration.ProtoReflect.Descriptor instead.
+func (*Duration) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Duration) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Duration) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type Evidence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChoiceId      string                 `protobuf:"bytes,2,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"` // present, absent or unknown
+	ObservedAt    string                 `protobuf:"bytes,3,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // initial, suggest, predefined or red_flags
+	Duration      *Duration              `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Evidence) Reset() {
+	*x = Evidence{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evidence) ProtoMessage() {}
+
+func (x *Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Evidence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Evidence) GetChoiceId() string {
+	if x != nil {
+		return x.ChoiceId
+	}
+	return ""
+}
+
+func (x *Evidence) GetObservedAt() string {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return ""
+}
+
+func (x *Evidence) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Evidence) GetDuration() *Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type ParseRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Text            string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Age             *Age                   `protobuf:"bytes,2,opt,name=age,proto3" json:"age,omitempty"`
+	Sex             string                 `protobuf:"bytes,3,opt,name=sex,proto3" json:"sex,omitempty"`
+	CorrectSpelling bool                   `protobuf:"varint,4,opt,name=correct_spelling,json=correctSpelling,proto3" json:"correct_spelling,omitempty"`
+	IncludeTokens   bool                   `protobuf:"varint,5,opt,name=include_tokens,json=includeTokens,proto3" json:"include_tokens,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParseRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetAge() *Age {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *ParseRequest) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetCorrectSpelling() bool {
+	if x != nil {
+		return x.CorrectSpelling
+	}
+	return false
+}
+
+func (x *ParseRequest) GetIncludeTokens() bool {
+	if x != nil {
+		return x.IncludeTokens
+	}
+	return false
+}
+
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Orth          string                 `protobuf:"bytes,2,opt,name=orth,proto3" json:"orth,omitempty"`
+	ChoiceId      string                 `protobuf:"bytes,3,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CommonName    string                 `protobuf:"bytes,5,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"` // symptom or risk_factor
+	Positions     []int32                `protobuf:"varint,7,rep,packed,name=positions,proto3" json:"positions,omitempty"`
+	HeadPosition  *int32                 `protobuf:"varint,8,opt,name=head_position,json=headPosition,proto3,oneof" json:"head_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Mention) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Mention) GetOrth() string {
+	if x != nil {
+		return x.Orth
+	}
+	return ""
+}
+
+func (x *Mention) GetChoiceId() string {
+	if x != nil {
+		return x.ChoiceId
+	}
+	return ""
+}
+
+func (x *Mention) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Mention) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *Mention) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Mention) GetPositions() []int32 {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *Mention) GetHeadPosition() int32 {
+	if x != nil && x.HeadPosition != nil {
+		return *x.HeadPosition
+	}
+	return 0
+}
+
+type ParseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*Mention             `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Tokens        []string               `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Obvious       bool                   `protobuf:"varint,3,opt,name=obvious,proto3" json:"obvious,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParseResponse) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *ParseResponse) GetObvious() bool {
+	if x != nil {
+		return x.Obvious
+	}
+	return false
+}
+
+type DiagnosisRequestExtras struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	EnableTriage_3             bool                   `protobuf:"varint,1,opt,name=enable_triage_3,json=enableTriage3,proto3" json:"enable_triage_3,omitempty"`
+	InterviewMode              string                 `protobuf:"bytes,2,opt,name=interview_mode,json=interviewMode,proto3" json:"interview_mode,omitempty"`
+	DisableAdaptiveRanking     bool                   `protobuf:"varint,3,opt,name=disable_adaptive_ranking,json=disableAdaptiveRanking,proto3" json:"disable_adaptive_ranking,omitempty"`
+	EnableExplanations         bool                   `protobuf:"varint,4,opt,name=enable_explanations,json=enableExplanations,proto3" json:"enable_explanations,omitempty"`
+	EnableThirdPersonQuestions bool                   `protobuf:"varint,5,opt,name=enable_third_person_questions,json=enableThirdPersonQuestions,proto3" json:"enable_third_person_questions,omitempty"`
+	IncludeConditionDetails    bool                   `protobuf:"varint,6,opt,name=include_condition_details,json=includeConditionDetails,proto3" json:"include_condition_details,omitempty"`
+	DisableIntimateContent     bool                   `protobuf:"varint,7,opt,name=disable_intimate_content,json=disableIntimateContent,proto3" json:"disable_intimate_content,omitempty"`
+	EnableSymptomDuration      bool                   `protobuf:"varint,8,opt,name=enable_symptom_duration,json=enableSymptomDuration,proto3" json:"enable_symptom_duration,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *DiagnosisRequestExtras) Reset() {
+	*x = DiagnosisRequestExtras{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosisRequestExtras) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosisRequestExtras) ProtoMessage() {}
+
+func (x *DiagnosisRequestExtras) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosisRequestExtras.ProtoReflect.Descriptor instead.
+func (*DiagnosisRequestExtras) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiagnosisRequestExtras) GetEnableTriage_3() bool {
+	if x != nil {
+		return x.EnableTriage_3
+	}
+	return false
+}
+
+func (x *DiagnosisRequestExtras) GetInterviewMode() string {
+	if x != nil {
+		return x.InterviewMode
+	}
+	return ""
+}
+
+func (x *DiagnosisRequestExtras) GetDisableAdaptiveRanking() bool {
+	if x != nil {
+		return x.DisableAdaptiveRanking
+	}
+	return false
+}
+
+func (x *DiagnosisRequestExtras) GetEnableExplanations() bool {
+	if x != nil {
+		return x.EnableExplanations
+	}
+	return false
+}
+
+func (x *DiagnosisRequestExtras) GetEnableThirdPersonQuestions() bool {
+	if x != nil {
+		return x.EnableThirdPersonQuestions
+	}
+	return false
+}
+
+func (x *DiagnosisRequestExtras) GetIncludeConditionDetails() bool {
+	if x != nil {
+		return x.IncludeConditionDetails
+	}
+	return false
+}
+
+func (x *DiagnosisRequestExtras) GetDisableIntimateContent() bool {
+	if x != nil {
+		return x.DisableIntimateContent
+	}
+	return false
+}
+
+func (x *DiagnosisRequestExtras) GetEnableSymptomDuration() bool {
+	if x != nil {
+		return x.EnableSymptomDuration
+	}
+	return false
+}
+
+type DiagnosisRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sex           string                  `protobuf:"bytes,1,opt,name=sex,proto3" json:"sex,omitempty"`
+	Age           *Age                    `protobuf:"bytes,2,opt,name=age,proto3" json:"age,omitempty"`
+	EvaluatedAt   string                  `protobuf:"bytes,3,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	Evidence      []*Evidence             `protobuf:"bytes,4,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Extras        *DiagnosisRequestExtras `protobuf:"bytes,5,opt,name=extras,proto3" json:"extras,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosisRequest) Reset() {
+	*x = DiagnosisRequest{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosisRequest) ProtoMessage() {}
+
+func (x *DiagnosisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosisRequest.ProtoReflect.Descriptor instead.
+func (*DiagnosisRequest) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiagnosisRequest) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *DiagnosisRequest) GetAge() *Age {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *DiagnosisRequest) GetEvaluatedAt() string {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return ""
+}
+
+func (x *DiagnosisRequest) GetEvidence() []*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *DiagnosisRequest) GetExtras() *DiagnosisRequestExtras {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+type QuestionItemChoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionItemChoice) Reset() {
+	*x = QuestionItemChoice{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionItemChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionItemChoice) ProtoMessage() {}
+
+func (x *QuestionItemChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionItemChoice.ProtoReflect.Descriptor instead.
+func (*QuestionItemChoice) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuestionItemChoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionItemChoice) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type QuestionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Choices       []*QuestionItemChoice  `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+	Explication   string                 `protobuf:"bytes,4,opt,name=explication,proto3" json:"explication,omitempty"`
+	Instruction   []string               `protobuf:"bytes,5,rep,name=instruction,proto3" json:"instruction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionItem) Reset() {
+	*x = QuestionItem{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionItem) ProtoMessage() {}
+
+func (x *QuestionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionItem.ProtoReflect.Descriptor instead.
+func (*QuestionItem) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuestionItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuestionItem) GetChoices() []*QuestionItemChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuestionItem) GetExplication() string {
+	if x != nil {
+		return x.Explication
+	}
+	return ""
+}
+
+func (x *QuestionItem) GetInstruction() []string {
+	if x != nil {
+		return x.Instruction
+	}
+	return nil
+}
+
+type Question struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // single, group_single, group_multiple or duration
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	EvidenceId    string                 `protobuf:"bytes,3,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
+	Items         []*QuestionItem        `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Extras        *structpb.Struct       `protobuf:"bytes,5,opt,name=extras,proto3" json:"extras,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Question) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Question) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Question) GetEvidenceId() string {
+	if x != nil {
+		return x.EvidenceId
+	}
+	return ""
+}
+
+func (x *Question) GetItems() []*QuestionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Question) GetExtras() *structpb.Struct {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+type ConditionCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConditionCategory) Reset() {
+	*x = ConditionCategory{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConditionCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionCategory) ProtoMessage() {}
+
+func (x *ConditionCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionCategory.ProtoReflect.Descriptor instead.
+func (*ConditionCategory) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConditionCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConditionCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConditionDetails struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Icd10Code           string                 `protobuf:"bytes,1,opt,name=icd10_code,json=icd10Code,proto3" json:"icd10_code,omitempty"`
+	Category            *ConditionCategory     `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Prevalence          string                 `protobuf:"bytes,3,opt,name=prevalence,proto3" json:"prevalence,omitempty"`
+	Severity            string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Acuteness           string                 `protobuf:"bytes,5,opt,name=acuteness,proto3" json:"acuteness,omitempty"`
+	TriageLevel         string                 `protobuf:"bytes,6,opt,name=triage_level,json=triageLevel,proto3" json:"triage_level,omitempty"`
+	Hint                string                 `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	HasPatientEducation bool                   `protobuf:"varint,8,opt,name=has_patient_education,json=hasPatientEducation,proto3" json:"has_patient_education,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ConditionDetails) Reset() {
+	*x = ConditionDetails{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConditionDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionDetails) ProtoMessage() {}
+
+func (x *ConditionDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionDetails.ProtoReflect.Descriptor instead.
+func (*ConditionDetails) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConditionDetails) GetIcd10Code() string {
+	if x != nil {
+		return x.Icd10Code
+	}
+	return ""
+}
+
+func (x *ConditionDetails) GetCategory() *ConditionCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *ConditionDetails) GetPrevalence() string {
+	if x != nil {
+		return x.Prevalence
+	}
+	return ""
+}
+
+func (x *ConditionDetails) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ConditionDetails) GetAcuteness() string {
+	if x != nil {
+		return x.Acuteness
+	}
+	return ""
+}
+
+func (x *ConditionDetails) GetTriageLevel() string {
+	if x != nil {
+		return x.TriageLevel
+	}
+	return ""
+}
+
+func (x *ConditionDetails) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *ConditionDetails) GetHasPatientEducation() bool {
+	if x != nil {
+		return x.HasPatientEducation
+	}
+	return false
+}
+
+type Condition struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CommonName       string                 `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Probability      float64                `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"`
+	ConditionDetails *ConditionDetails      `protobuf:"bytes,5,opt,name=condition_details,json=conditionDetails,proto3" json:"condition_details,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Condition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Condition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Condition) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *Condition) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *Condition) GetConditionDetails() *ConditionDetails {
+	if x != nil {
+		return x.ConditionDetails
+	}
+	return nil
+}
+
+type DiagnosisResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Question             *Question              `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Conditions           []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	ShouldStop           bool                   `protobuf:"varint,3,opt,name=should_stop,json=shouldStop,proto3" json:"should_stop,omitempty"`
+	Extras               *structpb.Struct       `protobuf:"bytes,4,opt,name=extras,proto3" json:"extras,omitempty"`
+	HasEmergencyEvidence bool                   `protobuf:"varint,5,opt,name=has_emergency_evidence,json=hasEmergencyEvidence,proto3" json:"has_emergency_evidence,omitempty"`
+	InterviewToken       string                 `protobuf:"bytes,6,opt,name=interview_token,json=interviewToken,proto3" json:"interview_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DiagnosisResponse) Reset() {
+	*x = DiagnosisResponse{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosisResponse) ProtoMessage() {}
+
+func (x *DiagnosisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosisResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosisResponse) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiagnosisResponse) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *DiagnosisResponse) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *DiagnosisResponse) GetShouldStop() bool {
+	if x != nil {
+		return x.ShouldStop
+	}
+	return false
+}
+
+func (x *DiagnosisResponse) GetExtras() *structpb.Struct {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+func (x *DiagnosisResponse) GetHasEmergencyEvidence() bool {
+	if x != nil {
+		return x.HasEmergencyEvidence
+	}
+	return false
+}
+
+func (x *DiagnosisResponse) GetInterviewToken() string {
+	if x != nil {
+		return x.InterviewToken
+	}
+	return ""
+}
+
+type TriageRequestExtras struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EnableTriage_3        bool                   `protobuf:"varint,1,opt,name=enable_triage_3,json=enableTriage3,proto3" json:"enable_triage_3,omitempty"`
+	EnableSymptomDuration bool                   `protobuf:"varint,2,opt,name=enable_symptom_duration,json=enableSymptomDuration,proto3" json:"enable_symptom_duration,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TriageRequestExtras) Reset() {
+	*x = TriageRequestExtras{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriageRequestExtras) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriageRequestExtras) ProtoMessage() {}
+
+func (x *TriageRequestExtras) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriageRequestExtras.ProtoReflect.Descriptor instead.
+func (*TriageRequestExtras) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TriageRequestExtras) GetEnableTriage_3() bool {
+	if x != nil {
+		return x.EnableTriage_3
+	}
+	return false
+}
+
+func (x *TriageRequestExtras) GetEnableSymptomDuration() bool {
+	if x != nil {
+		return x.EnableSymptomDuration
+	}
+	return false
+}
+
+type TriageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sex           string                 `protobuf:"bytes,1,opt,name=sex,proto3" json:"sex,omitempty"`
+	Age           *Age                   `protobuf:"bytes,2,opt,name=age,proto3" json:"age,omitempty"`
+	Evidence      []*Evidence            `protobuf:"bytes,3,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	EvaluatedAt   string                 `protobuf:"bytes,4,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	Extras        *TriageRequestExtras   `protobuf:"bytes,5,opt,name=extras,proto3" json:"extras,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriageRequest) Reset() {
+	*x = TriageRequest{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriageRequest) ProtoMessage() {}
+
+func (x *TriageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriageRequest.ProtoReflect.Descriptor instead.
+func (*TriageRequest) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TriageRequest) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *TriageRequest) GetAge() *Age {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *TriageRequest) GetEvidence() []*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *TriageRequest) GetEvaluatedAt() string {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return ""
+}
+
+func (x *TriageRequest) GetExtras() *TriageRequestExtras {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+type Serious struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CommonName    string                 `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Seriousness   string                 `protobuf:"bytes,4,opt,name=seriousness,proto3" json:"seriousness,omitempty"`
+	IsEmergency   bool                   `protobuf:"varint,5,opt,name=is_emergency,json=isEmergency,proto3" json:"is_emergency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Serious) Reset() {
+	*x = Serious{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Serious) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Serious) ProtoMessage() {}
+
+func (x *Serious) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Serious.ProtoReflect.Descriptor instead.
+func (*Serious) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Serious) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Serious) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Serious) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *Serious) GetSeriousness() string {
+	if x != nil {
+		return x.Seriousness
+	}
+	return ""
+}
+
+func (x *Serious) GetIsEmergency() bool {
+	if x != nil {
+		return x.IsEmergency
+	}
+	return false
+}
+
+type TriageResponse struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	TriageLevel                string                 `protobuf:"bytes,1,opt,name=triage_level,json=triageLevel,proto3" json:"triage_level,omitempty"`
+	Serious                    []*Serious             `protobuf:"bytes,2,rep,name=serious,proto3" json:"serious,omitempty"`
+	TeleconsultationApplicable bool                   `protobuf:"varint,3,opt,name=teleconsultation_applicable,json=teleconsultationApplicable,proto3" json:"teleconsultation_applicable,omitempty"`
+	RootCause                  string                 `protobuf:"bytes,4,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *TriageResponse) Reset() {
+	*x = TriageResponse{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriageResponse) ProtoMessage() {}
+
+func (x *TriageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriageResponse.ProtoReflect.Descriptor instead.
+func (*TriageResponse) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TriageResponse) GetTriageLevel() string {
+	if x != nil {
+		return x.TriageLevel
+	}
+	return ""
+}
+
+func (x *TriageResponse) GetSerious() []*Serious {
+	if x != nil {
+		return x.Serious
+	}
+	return nil
+}
+
+func (x *TriageResponse) GetTeleconsultationApplicable() bool {
+	if x != nil {
+		return x.TeleconsultationApplicable
+	}
+	return false
+}
+
+func (x *TriageResponse) GetRootCause() string {
+	if x != nil {
+		return x.RootCause
+	}
+	return ""
+}
+
+type SuggestRequestExtras struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EnableExplanations    bool                   `protobuf:"varint,1,opt,name=enable_explanations,json=enableExplanations,proto3" json:"enable_explanations,omitempty"`
+	EnableSymptomDuration bool                   `protobuf:"varint,2,opt,name=enable_symptom_duration,json=enableSymptomDuration,proto3" json:"enable_symptom_duration,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SuggestRequestExtras) Reset() {
+	*x = SuggestRequestExtras{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequestExtras) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequestExtras) ProtoMessage() {}
+
+func (x *SuggestRequestExtras) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequestExtras.ProtoReflect.Descriptor instead.
+func (*SuggestRequestExtras) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SuggestRequestExtras) GetEnableExplanations() bool {
+	if x != nil {
+		return x.EnableExplanations
+	}
+	return false
+}
+
+func (x *SuggestRequestExtras) GetEnableSymptomDuration() bool {
+	if x != nil {
+		return x.EnableSymptomDuration
+	}
+	return false
+}
+
+type SuggestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sex           string                 `protobuf:"bytes,1,opt,name=sex,proto3" json:"sex,omitempty"`
+	Age           *Age                   `protobuf:"bytes,2,opt,name=age,proto3" json:"age,omitempty"`
+	EvaluatedAt   string                 `protobuf:"bytes,3,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	Evidence      []*Evidence            `protobuf:"bytes,4,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	SuggestMethod string                 `protobuf:"bytes,5,opt,name=suggest_method,json=suggestMethod,proto3" json:"suggest_method,omitempty"`
+	MaxResults    int32                  `protobuf:"varint,6,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	Extras        *SuggestRequestExtras  `protobuf:"bytes,7,opt,name=extras,proto3" json:"extras,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuggestRequest) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetAge() *Age {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *SuggestRequest) GetEvaluatedAt() string {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetEvidence() []*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *SuggestRequest) GetSuggestMethod() string {
+	if x != nil {
+		return x.SuggestMethod
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *SuggestRequest) GetExtras() *SuggestRequestExtras {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CommonName    string                 `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Explication   string                 `protobuf:"bytes,4,opt,name=explication,proto3" json:"explication,omitempty"`
+	Instruction   []string               `protobuf:"bytes,5,rep,name=instruction,proto3" json:"instruction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Suggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Suggestion) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *Suggestion) GetExplication() string {
+	if x != nil {
+		return x.Explication
+	}
+	return ""
+}
+
+func (x *Suggestion) GetInstruction() []string {
+	if x != nil {
+		return x.Instruction
+	}
+	return nil
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type RecommendSpecialistRequestExtras struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EnableSymptomDuration bool                   `protobuf:"varint,1,opt,name=enable_symptom_duration,json=enableSymptomDuration,proto3" json:"enable_symptom_duration,omitempty"`
+	SpecialistMapping     map[string]string      `protobuf:"bytes,2,rep,name=specialist_mapping,json=specialistMapping,proto3" json:"specialist_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RecommendSpecialistRequestExtras) Reset() {
+	*x = RecommendSpecialistRequestExtras{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendSpecialistRequestExtras) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendSpecialistRequestExtras) ProtoMessage() {}
+
+func (x *RecommendSpecialistRequestExtras) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendSpecialistRequestExtras.ProtoReflect.Descriptor instead.
+func (*RecommendSpecialistRequestExtras) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RecommendSpecialistRequestExtras) GetEnableSymptomDuration() bool {
+	if x != nil {
+		return x.EnableSymptomDuration
+	}
+	return false
+}
+
+func (x *RecommendSpecialistRequestExtras) GetSpecialistMapping() map[string]string {
+	if x != nil {
+		return x.SpecialistMapping
+	}
+	return nil
+}
+
+type RecommendSpecialistRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Sex           string                            `protobuf:"bytes,1,opt,name=sex,proto3" json:"sex,omitempty"`
+	Age           *Age                              `protobuf:"bytes,2,opt,name=age,proto3" json:"age,omitempty"`
+	EvaluatedAt   string                            `protobuf:"bytes,3,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	Evidence      []*Evidence                       `protobuf:"bytes,4,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Extras        *RecommendSpecialistRequestExtras `protobuf:"bytes,5,opt,name=extras,proto3" json:"extras,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendSpecialistRequest) Reset() {
+	*x = RecommendSpecialistRequest{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendSpecialistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendSpecialistRequest) ProtoMessage() {}
+
+func (x *RecommendSpecialistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendSpecialistRequest.ProtoReflect.Descriptor instead.
+func (*RecommendSpecialistRequest) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecommendSpecialistRequest) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *RecommendSpecialistRequest) GetAge() *Age {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *RecommendSpecialistRequest) GetEvaluatedAt() string {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return ""
+}
+
+func (x *RecommendSpecialistRequest) GetEvidence() []*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *RecommendSpecialistRequest) GetExtras() *RecommendSpecialistRequestExtras {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+type Specialist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Specialist) Reset() {
+	*x = Specialist{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Specialist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Specialist) ProtoMessage() {}
+
+func (x *Specialist) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Specialist.ProtoReflect.Descriptor instead.
+func (*Specialist) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Specialist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Specialist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RecommendSpecialistResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RecommendedSpecialist *Specialist            `protobuf:"bytes,1,opt,name=recommended_specialist,json=recommendedSpecialist,proto3" json:"recommended_specialist,omitempty"`
+	RecommendedChannel    string                 `protobuf:"bytes,2,opt,name=recommended_channel,json=recommendedChannel,proto3" json:"recommended_channel,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RecommendSpecialistResponse) Reset() {
+	*x = RecommendSpecialistResponse{}
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendSpecialistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendSpecialistResponse) ProtoMessage() {}
+
+func (x *RecommendSpecialistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infermedica_v1_infermedica_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendSpecialistResponse.ProtoReflect.Descriptor instead.
+func (*RecommendSpecialistResponse) Descriptor() ([]byte, []int) {
+	return file_infermedica_v1_infermedica_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RecommendSpecialistResponse) GetRecommendedSpecialist() *Specialist {
+	if x != nil {
+		return x.RecommendedSpecialist
+	}
+	return nil
+}
+
+func (x *RecommendSpecialistResponse) GetRecommendedChannel() string {
+	if x != nil {
+		return x.RecommendedChannel
+	}
+	return ""
+}
+
+var File_infermedica_v1_infermedica_proto protoreflect.FileDescriptor
+
+const file_infermedica_v1_infermedica_proto_rawDesc = "" +
+	"\n" +
+	" infermedica/v1/infermedica.proto\x12\x0einfermedica.v1\x1a\x1cgoogle/protobuf/struct.proto\"/\n" +
+	"\x03Age\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"4\n" +
+	"\bDuration\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"\xa6\x01\n" +
+	"\bEvidence\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tchoice_id\x18\x02 \x01(\tR\bchoiceId\x12\x1f\n" +
+	"\vobserved_at\x18\x03 \x01(\tR\n" +
+	"observedAt\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x124\n" +
+	"\bduration\x18\x05 \x01(\v2\x18.infermedica.v1.DurationR\bduration\"\xad\x01\n" +
+	"\fParseRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12%\n" +
+	"\x03age\x18\x02 \x01(\v2\x13.infermedica.v1.AgeR\x03age\x12\x10\n" +
+	"\x03sex\x18\x03 \x01(\tR\x03sex\x12)\n" +
+	"\x10correct_spelling\x18\x04 \x01(\bR\x0fcorrectSpelling\x12%\n" +
+	"\x0einclude_tokens\x18\x05 \x01(\bR\rincludeTokens\"\xed\x01\n" +
+	"\aMention\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04orth\x18\x02 \x01(\tR\x04orth\x12\x1b\n" +
+	"\tchoice_id\x18\x03 \x01(\tR\bchoiceId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcommon_name\x18\x05 \x01(\tR\n" +
+	"commonName\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1c\n" +
+	"\tpositions\x18\a \x03(\x05R\tpositions\x12(\n" +
+	"\rhead_position\x18\b \x01(\x05H\x00R\fheadPosition\x88\x01\x01B\x10\n" +
+	"\x0e_head_position\"v\n" +
+	"\rParseResponse\x123\n" +
+	"\bmentions\x18\x01 \x03(\v2\x17.infermedica.v1.MentionR\bmentions\x12\x16\n" +
+	"\x06tokens\x18\x02 \x03(\tR\x06tokens\x12\x18\n" +
+	"\aobvious\x18\x03 \x01(\bR\aobvious\"\xc3\x03\n" +
+	"\x16DiagnosisRequestExtras\x12&\n" +
+	"\x0fenable_triage_3\x18\x01 \x01(\bR\renableTriage3\x12%\n" +
+	"\x0einterview_mode\x18\x02 \x01(\tR\rinterviewMode\x128\n" +
+	"\x18disable_adaptive_ranking\x18\x03 \x01(\bR\x16disableAdaptiveRanking\x12/\n" +
+	"\x13enable_explanations\x18\x04 \x01(\bR\x12enableExplanations\x12A\n" +
+	"\x1denable_third_person_questions\x18\x05 \x01(\bR\x1aenableThirdPersonQuestions\x12:\n" +
+	"\x19include_condition_details\x18\x06 \x01(\bR\x17includeConditionDetails\x128\n" +
+	"\x18disable_intimate_content\x18\a \x01(\bR\x16disableIntimateContent\x126\n" +
+	"\x17enable_symptom_duration\x18\b \x01(\bR\x15enableSymptomDuration\"\xe4\x01\n" +
+	"\x10DiagnosisRequest\x12\x10\n" +
+	"\x03sex\x18\x01 \x01(\tR\x03sex\x12%\n" +
+	"\x03age\x18\x02 \x01(\v2\x13.infermedica.v1.AgeR\x03age\x12!\n" +
+	"\fevaluated_at\x18\x03 \x01(\tR\vevaluatedAt\x124\n" +
+	"\bevidence\x18\x04 \x03(\v2\x18.infermedica.v1.EvidenceR\bevidence\x12>\n" +
+	"\x06extras\x18\x05 \x01(\v2&.infermedica.v1.DiagnosisRequestExtrasR\x06extras\":\n" +
+	"\x12QuestionItemChoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\"\xb4\x01\n" +
+	"\fQuestionItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12<\n" +
+	"\achoices\x18\x03 \x03(\v2\".infermedica.v1.QuestionItemChoiceR\achoices\x12 \n" +
+	"\vexplication\x18\x04 \x01(\tR\vexplication\x12 \n" +
+	"\vinstruction\x18\x05 \x03(\tR\vinstruction\"\xb8\x01\n" +
+	"\bQuestion\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1f\n" +
+	"\vevidence_id\x18\x03 \x01(\tR\n" +
+	"evidenceId\x122\n" +
+	"\x05items\x18\x04 \x03(\v2\x1c.infermedica.v1.QuestionItemR\x05items\x12/\n" +
+	"\x06extras\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06extras\"7\n" +
+	"\x11ConditionCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xb5\x02\n" +
+	"\x10ConditionDetails\x12\x1d\n" +
+	"\n" +
+	"icd10_code\x18\x01 \x01(\tR\ticd10Code\x12=\n" +
+	"\bcategory\x18\x02 \x01(\v2!.infermedica.v1.ConditionCategoryR\bcategory\x12\x1e\n" +
+	"\n" +
+	"prevalence\x18\x03 \x01(\tR\n" +
+	"prevalence\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12\x1c\n" +
+	"\tacuteness\x18\x05 \x01(\tR\tacuteness\x12!\n" +
+	"\ftriage_level\x18\x06 \x01(\tR\vtriageLevel\x12\x12\n" +
+	"\x04hint\x18\a \x01(\tR\x04hint\x122\n" +
+	"\x15has_patient_education\x18\b \x01(\bR\x13hasPatientEducation\"\xc1\x01\n" +
+	"\tCondition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcommon_name\x18\x03 \x01(\tR\n" +
+	"commonName\x12 \n" +
+	"\vprobability\x18\x04 \x01(\x01R\vprobability\x12M\n" +
+	"\x11condition_details\x18\x05 \x01(\v2 .infermedica.v1.ConditionDetailsR\x10conditionDetails\"\xb5\x02\n" +
+	"\x11DiagnosisResponse\x124\n" +
+	"\bquestion\x18\x01 \x01(\v2\x18.infermedica.v1.QuestionR\bquestion\x129\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x19.infermedica.v1.ConditionR\n" +
+	"conditions\x12\x1f\n" +
+	"\vshould_stop\x18\x03 \x01(\bR\n" +
+	"shouldStop\x12/\n" +
+	"\x06extras\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06extras\x124\n" +
+	"\x16has_emergency_evidence\x18\x05 \x01(\bR\x14hasEmergencyEvidence\x12'\n" +
+	"\x0finterview_token\x18\x06 \x01(\tR\x0einterviewToken\"u\n" +
+	"\x13TriageRequestExtras\x12&\n" +
+	"\x0fenable_triage_3\x18\x01 \x01(\bR\renableTriage3\x126\n" +
+	"\x17enable_symptom_duration\x18\x02 \x01(\bR\x15enableSymptomDuration\"\xde\x01\n" +
+	"\rTriageRequest\x12\x10\n" +
+	"\x03sex\x18\x01 \x01(\tR\x03sex\x12%\n" +
+	"\x03age\x18\x02 \x01(\v2\x13.infermedica.v1.AgeR\x03age\x124\n" +
+	"\bevidence\x18\x03 \x03(\v2\x18.infermedica.v1.EvidenceR\bevidence\x12!\n" +
+	"\fevaluated_at\x18\x04 \x01(\tR\vevaluatedAt\x12;\n" +
+	"\x06extras\x18\x05 \x01(\v2#.infermedica.v1.TriageRequestExtrasR\x06extras\"\x93\x01\n" +
+	"\aSerious\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcommon_name\x18\x03 \x01(\tR\n" +
+	"commonName\x12 \n" +
+	"\vseriousness\x18\x04 \x01(\tR\vseriousness\x12!\n" +
+	"\fis_emergency\x18\x05 \x01(\bR\visEmergency\"\xc6\x01\n" +
+	"\x0eTriageResponse\x12!\n" +
+	"\ftriage_level\x18\x01 \x01(\tR\vtriageLevel\x121\n" +
+	"\aserious\x18\x02 \x03(\v2\x17.infermedica.v1.SeriousR\aserious\x12?\n" +
+	"\x1bteleconsultation_applicable\x18\x03 \x01(\bR\x1ateleconsultationApplicable\x12\x1d\n" +
+	"\n" +
+	"root_cause\x18\x04 \x01(\tR\trootCause\"\x7f\n" +
+	"\x14SuggestRequestExtras\x12/\n" +
+	"\x13enable_explanations\x18\x01 \x01(\bR\x12enableExplanations\x126\n" +
+	"\x17enable_symptom_duration\x18\x02 \x01(\bR\x15enableSymptomDuration\"\xa8\x02\n" +
+	"\x0eSuggestRequest\x12\x10\n" +
+	"\x03sex\x18\x01 \x01(\tR\x03sex\x12%\n" +
+	"\x03age\x18\x02 \x01(\v2\x13.infermedica.v1.AgeR\x03age\x12!\n" +
+	"\fevaluated_at\x18\x03 \x01(\tR\vevaluatedAt\x124\n" +
+	"\bevidence\x18\x04 \x03(\v2\x18.infermedica.v1.EvidenceR\bevidence\x12%\n" +
+	"\x0esuggest_method\x18\x05 \x01(\tR\rsuggestMethod\x12\x1f\n" +
+	"\vmax_results\x18\x06 \x01(\x05R\n" +
+	"maxResults\x12<\n" +
+	"\x06extras\x18\a \x01(\v2$.infermedica.v1.SuggestRequestExtrasR\x06extras\"\x95\x01\n" +
+	"\n" +
+	"Suggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcommon_name\x18\x03 \x01(\tR\n" +
+	"commonName\x12 \n" +
+	"\vexplication\x18\x04 \x01(\tR\vexplication\x12 \n" +
+	"\vinstruction\x18\x05 \x03(\tR\vinstruction\"O\n" +
+	"\x0fSuggestResponse\x12<\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1a.infermedica.v1.SuggestionR\vsuggestions\"\x98\x02\n" +
+	" RecommendSpecialistRequestExtras\x126\n" +
+	"\x17enable_symptom_duration\x18\x01 \x01(\bR\x15enableSymptomDuration\x12v\n" +
+	"\x12specialist_mapping\x18\x02 \x03(\v2G.infermedica.v1.RecommendSpecialistRequestExtras.SpecialistMappingEntryR\x11specialistMapping\x1aD\n" +
+	"\x16SpecialistMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x01\n" +
+	"\x1aRecommendSpecialistRequest\x12\x10\n" +
+	"\x03sex\x18\x01 \x01(\tR\x03sex\x12%\n" +
+	"\x03age\x18\x02 \x01(\v2\x13.infermedica.v1.AgeR\x03age\x12!\n" +
+	"\fevaluated_at\x18\x03 \x01(\tR\vevaluatedAt\x124\n" +
+	"\bevidence\x18\x04 \x03(\v2\x18.infermedica.v1.EvidenceR\bevidence\x12H\n" +
+	"\x06extras\x18\x05 \x01(\v20.infermedica.v1.RecommendSpecialistRequestExtrasR\x06extras\"0\n" +
+	"\n" +
+	"Specialist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa1\x01\n" +
+	"\x1bRecommendSpecialistResponse\x12Q\n" +
+	"\x16recommended_specialist\x18\x01 \x01(\v2\x1a.infermedica.v1.SpecialistR\x15recommendedSpecialist\x12/\n" +
+	"\x13recommended_channel\x18\x02 \x01(\tR\x12recommendedChannel2\xaa\x03\n" +
+	"\vInfermedica\x12D\n" +
+	"\x05Parse\x12\x1c.infermedica.v1.ParseRequest\x1a\x1d.infermedica.v1.ParseResponse\x12P\n" +
+	"\tDiagnosis\x12 .infermedica.v1.DiagnosisRequest\x1a!.infermedica.v1.DiagnosisResponse\x12G\n" +
+	"\x06Triage\x12\x1d.infermedica.v1.TriageRequest\x1a\x1e.infermedica.v1.TriageResponse\x12J\n" +
+	"\aSuggest\x12\x1e.infermedica.v1.SuggestRequest\x1a\x1f.infermedica.v1.SuggestResponse\x12n\n" +
+	"\x13RecommendSpecialist\x12*.infermedica.v1.RecommendSpecialistRequest\x1a+.infermedica.v1.RecommendSpecialistResponseBGZEgithub.com/guiarnaldo/infermedica-v3/grpc/infermedicav1;infermedicav1b\x06proto3"
+
+var (
+	file_infermedica_v1_infermedica_proto_rawDescOnce sync.Once
+	file_infermedica_v1_infermedica_proto_rawDescData []byte
+)
+
+func file_infermedica_v1_infermedica_proto_rawDescGZIP() []byte {
+	file_infermedica_v1_infermedica_proto_rawDescOnce.Do(func() {
+		file_infermedica_v1_infermedica_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_infermedica_v1_infermedica_proto_rawDesc), len(file_infermedica_v1_infermedica_proto_rawDesc)))
+	})
+	return file_infermedica_v1_infermedica_proto_rawDescData
+}
+
+var file_infermedica_v1_infermedica_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_infermedica_v1_infermedica_proto_goTypes = []any{
+	(*Age)(nil),                              // 0: infermedica.v1.Age
+	(*Duration)(nil),                         // 1: infermedica.v1.Duration
+	(*Evidence)(nil),                         // 2: infermedica.v1.Evidence
+	(*ParseRequest)(nil),                     // 3: infermedica.v1.ParseRequest
+	(*Mention)(nil),                          // 4: infermedica.v1.Mention
+	(*ParseResponse)(nil),                    // 5: infermedica.v1.ParseResponse
+	(*DiagnosisRequestExtras)(nil),           // 6: infermedica.v1.DiagnosisRequestExtras
+	(*DiagnosisRequest)(nil),                 // 7: infermedica.v1.DiagnosisRequest
+	(*QuestionItemChoice)(nil),               // 8: infermedica.v1.QuestionItemChoice
+	(*QuestionItem)(nil),                     // 9: infermedica.v1.QuestionItem
+	(*Question)(nil),                         // 10: infermedica.v1.Question
+	(*ConditionCategory)(nil),                // 11: infermedica.v1.ConditionCategory
+	(*ConditionDetails)(nil),                 // 12: infermedica.v1.ConditionDetails
+	(*Condition)(nil),                        // 13: infermedica.v1.Condition
+	(*DiagnosisResponse)(nil),                // 14: infermedica.v1.DiagnosisResponse
+	(*TriageRequestExtras)(nil),              // 15: infermedica.v1.TriageRequestExtras
+	(*TriageRequest)(nil),                    // 16: infermedica.v1.TriageRequest
+	(*Serious)(nil),                          // 17: infermedica.v1.Serious
+	(*TriageResponse)(nil),                   // 18: infermedica.v1.TriageResponse
+	(*SuggestRequestExtras)(nil),             // 19: infermedica.v1.SuggestRequestExtras
+	(*SuggestRequest)(nil),                   // 20: infermedica.v1.SuggestRequest
+	(*Suggestion)(nil),                       // 21: infermedica.v1.Suggestion
+	(*SuggestResponse)(nil),                  // 22: infermedica.v1.SuggestResponse
+	(*RecommendSpecialistRequestExtras)(nil), // 23: infermedica.v1.RecommendSpecialistRequestExtras
+	(*RecommendSpecialistRequest)(nil),       // 24: infermedica.v1.RecommendSpecialistRequest
+	(*Specialist)(nil),                       // 25: infermedica.v1.Specialist
+	(*RecommendSpecialistResponse)(nil),      // 26: infermedica.v1.RecommendSpecialistResponse
+	nil,                                      // 27: infermedica.v1.RecommendSpecialistRequestExtras.SpecialistMappingEntry
+	(*structpb.Struct)(nil),                  // 28: google.protobuf.Struct
+}
+var file_infermedica_v1_infermedica_proto_depIdxs = []int32{
+	1,  // 0: infermedica.v1.Evidence.duration:type_name -> infermedica.v1.Duration
+	0,  // 1: infermedica.v1.ParseRequest.age:type_name -> infermedica.v1.Age
+	4,  // 2: infermedica.v1.ParseResponse.mentions:type_name -> infermedica.v1.Mention
+	0,  // 3: infermedica.v1.DiagnosisRequest.age:type_name -> infermedica.v1.Age
+	2,  // 4: infermedica.v1.DiagnosisRequest.evidence:type_name -> infermedica.v1.Evidence
+	6,  // 5: infermedica.v1.DiagnosisRequest.extras:type_name -> infermedica.v1.DiagnosisRequestExtras
+	8,  // 6: infermedica.v1.QuestionItem.choices:type_name -> infermedica.v1.QuestionItemChoice
+	9,  // 7: infermedica.v1.Question.items:type_name -> infermedica.v1.QuestionItem
+	28, // 8: infermedica.v1.Question.extras:type_name -> google.protobuf.Struct
+	11, // 9: infermedica.v1.ConditionDetails.category:type_name -> infermedica.v1.ConditionCategory
+	12, // 10: infermedica.v1.Condition.condition_details:type_name -> infermedica.v1.ConditionDetails
+	10, // 11: infermedica.v1.DiagnosisResponse.question:type_name -> infermedica.v1.Question
+	13, // 12: infermedica.v1.DiagnosisResponse.conditions:type_name -> infermedica.v1.Condition
+	28, // 13: infermedica.v1.DiagnosisResponse.extras:type_name -> google.protobuf.Struct
+	0,  // 14: infermedica.v1.TriageRequest.age:type_name -> infermedica.v1.Age
+	2,  // 15: infermedica.v1.TriageRequest.evidence:type_name -> infermedica.v1.Evidence
+	15, // 16: infermedica.v1.TriageRequest.extras:type_name -> infermedica.v1.TriageRequestExtras
+	17, // 17: infermedica.v1.TriageResponse.serious:type_name -> infermedica.v1.Serious
+	0,  // 18: infermedica.v1.SuggestRequest.age:type_name -> infermedica.v1.Age
+	2,  // 19: infermedica.v1.SuggestRequest.evidence:type_name -> infermedica.v1.Evidence
+	19, // 20: infermedica.v1.SuggestRequest.extras:type_name -> infermedica.v1.SuggestRequestExtras
+	21, // 21: infermedica.v1.SuggestResponse.suggestions:type_name -> infermedica.v1.Suggestion
+	27, // 22: infermedica.v1.RecommendSpecialistRequestExtras.specialist_mapping:type_name -> infermedica.v1.RecommendSpecialistRequestExtras.SpecialistMappingEntry
+	0,  // 23: infermedica.v1.RecommendSpecialistRequest.age:type_name -> infermedica.v1.Age
+	2,  // 24: infermedica.v1.RecommendSpecialistRequest.evidence:type_name -> infermedica.v1.Evidence
+	23, // 25: infermedica.v1.RecommendSpecialistRequest.extras:type_name -> infermedica.v1.RecommendSpecialistRequestExtras
+	25, // 26: infermedica.v1.RecommendSpecialistResponse.recommended_specialist:type_name -> infermedica.v1.Specialist
+	3,  // 27: infermedica.v1.Infermedica.Parse:input_type -> infermedica.v1.ParseRequest
+	7,  // 28: infermedica.v1.Infermedica.Diagnosis:input_type -> infermedica.v1.DiagnosisRequest
+	16, // 29: infermedica.v1.Infermedica.Triage:input_type -> infermedica.v1.TriageRequest
+	20, // 30: infermedica.v1.Infermedica.Suggest:input_type -> infermedica.v1.SuggestRequest
+	24, // 31: infermedica.v1.Infermedica.RecommendSpecialist:input_type -> infermedica.v1.RecommendSpecialistRequest
+	5,  // 32: infermedica.v1.Infermedica.Parse:output_type -> infermedica.v1.ParseResponse
+	14, // 33: infermedica.v1.Infermedica.Diagnosis:output_type -> infermedica.v1.DiagnosisResponse
+	18, // 34: infermedica.v1.Infermedica.Triage:output_type -> infermedica.v1.TriageResponse
+	22, // 35: infermedica.v1.Infermedica.Suggest:output_type -> infermedica.v1.SuggestResponse
+	26, // 36: infermedica.v1.Infermedica.RecommendSpecialist:output_type -> infermedica.v1.RecommendSpecialistResponse
+	32, // [32:37] is the sub-list for method output_type
+	27, // [27:32] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_infermedica_v1_infermedica_proto_init() }
+func file_infermedica_v1_infermedica_proto_init() {
+	if File_infermedica_v1_infermedica_proto != nil {
+		return
+	}
+	file_infermedica_v1_infermedica_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_infermedica_v1_infermedica_proto_rawDesc), len(file_infermedica_v1_infermedica_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_infermedica_v1_infermedica_proto_goTypes,
+		DependencyIndexes: file_infermedica_v1_infermedica_proto_depIdxs,
+		MessageInfos:      file_infermedica_v1_infermedica_proto_msgTypes,
+	}.Build()
+	File_infermedica_v1_infermedica_proto = out.File
+	file_infermedica_v1_infermedica_proto_goTypes = nil
+	file_infermedica_v1_infermedica_proto_depIdxs = nil
+}
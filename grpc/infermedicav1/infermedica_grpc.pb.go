@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: infermedica/v1/infermedica.proto
+
+// Package infermedica.v1 mirrors the request and response types of the Go client.
+// Enumerations are strings with the values of the Infermedica API, so values added
+// by the API are passed through unchanged.
+//
+// The headers of a call are set with gRPC metadata: "interview-id", "model", "language" and "dev-mode".
+
+package infermedicav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Infermedica_Parse_FullMethodName               = "/infermedica.v1.Infermedica/Parse"
+	Infermedica_Diagnosis_FullMethodName           = "/infermedica.v1.Infermedica/Diagnosis"
+	Infermedica_Triage_FullMethodName              = "/infermedica.v1.Infermedica/Triage"
+	Infermedica_Suggest_FullMethodName             = "/infermedica.v1.Infermedica/Suggest"
+	Infermedica_RecommendSpecialist_FullMethodName = "/infermedica.v1.Infermedica/RecommendSpecialist"
+)
+
+// InfermedicaClient is the client API for Infermedica service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InfermedicaClient interface {
+	// Parse returns the observations mentioned in a text.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// Diagnosis returns the next question and the ranking of conditions.
+	Diagnosis(ctx context.Context, in *DiagnosisRequest, opts ...grpc.CallOption) (*DiagnosisResponse, error)
+	// Triage estimates the triage level of the case.
+	Triage(ctx context.Context, in *TriageRequest, opts ...grpc.CallOption) (*TriageResponse, error)
+	// Suggest returns observations related to the evidence.
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	// RecommendSpecialist returns the specialist and channel of consultation.
+	RecommendSpecialist(ctx context.Context, in *RecommendSpecialistRequest, opts ...grpc.CallOption) (*RecommendSpecialistResponse, error)
+}
+
+type infermedicaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInfermedicaClient(cc grpc.ClientConnInterface) InfermedicaClient {
+	return &infermedicaClient{cc}
+}
+
+func (c *infermedicaClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, Infermedica_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infermedicaClient) Diagnosis(ctx context.Context, in *DiagnosisRequest, opts ...grpc.CallOption) (*DiagnosisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiagnosisResponse)
+	err := c.cc.Invoke(ctx, Infermedica_Diagnosis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infermedicaClient) Triage(ctx context.Context, in *TriageRequest, opts ...grpc.CallOption) (*TriageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriageResponse)
+	err := c.cc.Invoke(ctx, Infermedica_Triage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infermedicaClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, Infermedica_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infermedicaClient) RecommendSpecialist(ctx context.Context, in *RecommendSpecialistRequest, opts ...grpc.CallOption) (*RecommendSpecialistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendSpecialistResponse)
+	err := c.cc.Invoke(ctx, Infermedica_RecommendSpecialist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InfermedicaServer is the server API for Infermedica service.
+// All implementations must embed UnimplementedInfermedicaServer
+// for forward compatibility.
+type InfermedicaServer interface {
+	// Parse returns the observations mentioned in a text.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// Diagnosis returns the next question and the ranking of conditions.
+	Diagnosis(context.Context, *DiagnosisRequest) (*DiagnosisResponse, error)
+	// Triage estimates the triage level of the case.
+	Triage(context.Context, *TriageRequest) (*TriageResponse, error)
+	// Suggest returns observations related to the evidence.
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	// RecommendSpecialist returns the specialist and channel of consultation.
+	RecommendSpecialist(context.Context, *RecommendSpecialistRequest) (*RecommendSpecialistResponse, error)
+	mustEmbedUnimplementedInfermedicaServer()
+}
+
+// UnimplementedInfermedicaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInfermedicaServer struct{}
+
+func (UnimplementedInfermedicaServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedInfermedicaServer) Diagnosis(context.Context, *DiagnosisRequest) (*DiagnosisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diagnosis not implemented")
+}
+func (UnimplementedInfermedicaServer) Triage(context.Context, *TriageRequest) (*TriageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Triage not implemented")
+}
+func (UnimplementedInfermedicaServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedInfermedicaServer) RecommendSpecialist(context.Context, *RecommendSpecialistRequest) (*RecommendSpecialistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendSpecialist not implemented")
+}
+func (UnimplementedInfermedicaServer) mustEmbedUnimplementedInfermedicaServer() {}
+func (UnimplementedInfermedicaServer) testEmbeddedByValue()                     {}
+
+// UnsafeInfermedicaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InfermedicaServer will
+// result in compilation errors.
+type UnsafeInfermedicaServer interface {
+	mustEmbedUnimplementedInfermedicaServer()
+}
+
+func RegisterInfermedicaServer(s grpc.ServiceRegistrar, srv InfermedicaServer) {
+	// If the following call pancis, it indicates UnimplementedInfermedicaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Infermedica_ServiceDesc, srv)
+}
+
+func _Infermedica_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfermedicaServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Infermedica_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfermedicaServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Infermedica_Diagnosis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnosisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfermedicaServer).Diagnosis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Infermedica_Diagnosis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfermedicaServer).Diagnosis(ctx, req.(*DiagnosisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Infermedica_Triage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfermedicaServer).Triage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Infermedica_Triage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfermedicaServer).Triage(ctx, req.(*TriageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Infermedica_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfermedicaServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Infermedica_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfermedicaServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Infermedica_RecommendSpecialist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendSpecialistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfermedicaServer).RecommendSpecialist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Infermedica_RecommendSpecialist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfermedicaServer).RecommendSpecialist(ctx, req.(*RecommendSpecialistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Infermedica_ServiceDesc is the grpc.ServiceDesc for Infermedica service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Infermedica_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "infermedica.v1.Infermedica",
+	HandlerType: (*InfermedicaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _Infermedica_Parse_Handler,
+		},
+		{
+			MethodName: "Diagnosis",
+			Handler:    _Infermedica_Diagnosis_Handler,
+		},
+		{
+			MethodName: "Triage",
+			Handler:    _Infermedica_Triage_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Infermedica_Suggest_Handler,
+		},
+		{
+			MethodName: "RecommendSpecialist",
+			Handler:    _Infermedica_RecommendSpecialist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "infermedica/v1/infermedica.proto",
+}
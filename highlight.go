@@ -0,0 +1,140 @@
+package infermedica
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MentionSpan is the position of a mention in the original text, Start and End are byte offsets
+type MentionSpan struct {
+	Mention Mention
+	Start   int
+	End     int
+}
+
+// Spans locates the mentions in the text given to Parse, mentions that can not be found are skipped.
+// Token positions are used when IncludeTokens was true, otherwise the Orth of each mention is searched.
+// The spans are sorted by Start
+func (p *ParseRes) Spans(text string) []MentionSpan {
+	tokens := tokenOffsets(text, p.Tokens)
+
+	var spans []MentionSpan
+	used := map[int]bool{}
+	for _, m := range p.Mentions {
+		start, end := -1, -1
+		for _, pos := range m.Positions {
+			if pos < 0 || pos >= len(tokens) || tokens[pos][0] < 0 {
+				start, end = -1, -1
+				break
+			}
+			if start < 0 || tokens[pos][0] < start {
+				start = tokens[pos][0]
+			}
+			if tokens[pos][1] > end {
+				end = tokens[pos][1]
+			}
+		}
+		if start < 0 && m.Orth != "" {
+			// The same phrase can be mentioned more than once, look for the next unused occurrence
+			for from := 0; from < len(text); {
+				i, j := indexFold(text, m.Orth, from)
+				if i < 0 {
+					break
+				}
+				if !used[i] {
+					start, end = i, j
+					break
+				}
+				_, size := utf8.DecodeRuneInString(text[i:])
+				from = i + size
+			}
+		}
+		if start < 0 || end > len(text) {
+			continue
+		}
+		used[start] = true
+		spans = append(spans, MentionSpan{Mention: m, Start: start, End: end})
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].Start < spans[j].Start
+	})
+	return spans
+}
+
+// Highlight returns the text with every recognized span replaced by the result of mark,
+// overlapping spans are only marked once
+func (p *ParseRes) Highlight(text string, mark func(m Mention, fragment string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, s := range p.Spans(text) {
+		if s.Start < last {
+			continue
+		}
+		b.WriteString(text[last:s.Start])
+		b.WriteString(mark(s.Mention, text[s.Start:s.End]))
+		last = s.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// tokenOffsets finds the tokens in order in the text, tokens that are not found (e.g. after spelling correction) are [-1, -1]
+func tokenOffsets(text string, tokens []string) [][2]int {
+	offsets := make([][2]int, len(tokens))
+	cursor := 0
+	for i, t := range tokens {
+		start, end := -1, -1
+		if t != "" {
+			start, end = indexFold(text, t, cursor)
+		}
+		if start < 0 {
+			offsets[i] = [2]int{-1, -1}
+			continue
+		}
+		offsets[i] = [2]int{start, end}
+		cursor = end
+	}
+	return offsets
+}
+
+// indexFold returns the byte offsets in s of the first case-insensitive occurrence of substr at or after from,
+// or -1, -1. The offsets are computed on s itself, as case mapping can change the length of a string
+func indexFold(s, substr string, from int) (start, end int) {
+	for i := from; i < len(s); {
+		if j, ok := hasPrefixFold(s[i:], substr); ok {
+			return i, i + j
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return -1, -1
+}
+
+// hasPrefixFold reports whether s starts with prefix under simple case folding and returns the length of the prefix in s
+func hasPrefixFold(s, prefix string) (int, bool) {
+	n := 0
+	for prefix != "" {
+		if n >= len(s) {
+			return 0, false
+		}
+		r1, size1 := utf8.DecodeRuneInString(s[n:])
+		r2, size2 := utf8.DecodeRuneInString(prefix)
+		if r1 != r2 && !equalFoldRune(r1, r2) {
+			return 0, false
+		}
+		n += size1
+		prefix = prefix[size2:]
+	}
+	return n, true
+}
+
+func equalFoldRune(a, b rune) bool {
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Package hl7 generates HL7 v2 messages from interview results.
+package hl7
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	FieldSeparator = "|"
+	EncodingChars  = `^~\&` // Component, repetition, escape and subcomponent separators
+	TimeFormat     = "20060102150405-0700"
+)
+
+var escaper = strings.NewReplacer(
+	`\`, `\E\`,
+	`|`, `\F\`,
+	`^`, `\S\`,
+	`&`, `\T\`,
+	`~`, `\R\`,
+	"\r", `\X0D\`,
+	"\n", `\X0A\`,
+)
+
+// Escape escapes the separators of the text of a field or component
+func Escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// Segment is a segment, the first field is its ID, fields are already escaped
+type Segment []string
+
+// String returns the segment with its fields separated, without the segment terminator
+func (s Segment) String() string {
+	return strings.Join(s, FieldSeparator)
+}
+
+// Message is a list of segments
+type Message []Segment
+
+// String returns the message with segments terminated by a carriage return
+func (m Message) String() string {
+	var sb strings.Builder
+	for _, s := range m {
+		sb.WriteString(s.String())
+		sb.WriteByte('\r')
+	}
+	return sb.String()
+}
+
+// Bytes returns the message as sent over MLLP, without the framing
+func (m Message) Bytes() []byte {
+	return []byte(m.String())
+}
+
+// Field returns a field from its components, escaping each one and removing trailing empty components
+func Field(components ...string) string {
+	for len(components) > 0 && components[len(components)-1] == "" {
+		components = components[:len(components)-1]
+	}
+	escaped := make([]string, len(components))
+	for i, c := range components {
+		escaped[i] = Escape(c)
+	}
+	return strings.Join(escaped, EncodingChars[:1])
+}
+
+// FormatTime formats a time as a HL7 DTM
+func FormatTime(t time.Time) string {
+	return t.Format(TimeFormat)
+}
@@ -0,0 +1,44 @@
+package hl7
+
+import "testing"
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain text", "plain text"},
+		{"a|b", `a\F\b`},
+		{"a^b", `a\S\b`},
+		{"a~b", `a\R\b`},
+		{`a\b`, `a\E\b`},
+		{"a&b", `a\T\b`},
+		{"a\rb", `a\X0D\b`},
+		{"a\nb", `a\X0A\b`},
+		{`\F\`, `\E\F\E\`},
+		{"|^~\\&\r\n", `\F\\S\\R\\E\\T\\X0D\\X0A\`},
+	}
+	for _, tt := range tests {
+		if got := Escape(tt.in); got != tt.want {
+			t.Errorf("Escape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		components []string
+		want       string
+	}{
+		{nil, ""},
+		{[]string{"a"}, "a"},
+		{[]string{"a", "b", "c"}, "a^b^c"},
+		{[]string{"a", "", "c"}, "a^^c"},
+		{[]string{"a", "b", "", ""}, "a^b"},
+		{[]string{"x^y", "p|q"}, `x\S\y^p\F\q`},
+	}
+	for _, tt := range tests {
+		if got := Field(tt.components...); got != tt.want {
+			t.Errorf("Field(%q) = %q, want %q", tt.components, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package hl7
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+const (
+	CodingSystemLocal = "L"       // Infermedica IDs and local codes
+	CodingSystemICD10 = "I10"     // ICD-10
+	CodingSystemLOINC = "LN"      // LOINC
+	CodingSystemUCUM  = "UCUM"    // UCUM units
+	CodingSystemYesNo = "HL70136" // Yes/no indicator
+	DefaultVersion    = "2.5.1"
+	DefaultProcessing = "P" // Production
+	DefaultConditions = 5
+)
+
+// Config sets the MSH fields and the content of the ORU^R01 messages
+type Config struct {
+	SendingApplication   string
+	SendingFacility      string
+	ReceivingApplication string
+	ReceivingFacility    string
+	ProcessingID         string // DefaultProcessing when empty
+	Version              string // DefaultVersion when empty
+	MaxConditions        int    // DefaultConditions when zero, all when negative
+}
+
+// ORUOptions are the values of a single message
+type ORUOptions struct {
+	ControlID string    // MSH-10, the interview ID when empty
+	PatientID string    // PID-3, the interview ID when empty
+	Time      time.Time // MSH-7, now when zero
+}
+
+var yesNo = map[infermedica.EvidenceChoiceID][2]string{
+	infermedica.EvidenceChoiceIDPresent: {"Y", "Yes"},
+	infermedica.EvidenceChoiceIDAbsent:  {"N", "No"},
+	infermedica.EvidenceChoiceIDUnknown: {"UNK", "Unknown"},
+}
+
+var ucum = map[infermedica.DurationUnit]string{
+	infermedica.DurationUnitWeek:   "wk",
+	infermedica.DurationUnitDay:    "d",
+	infermedica.DurationUnitHour:   "h",
+	infermedica.DurationUnitMinute: "min",
+}
+
+// ORU returns an ORU^R01 message with the age, the triage level, the top conditions with their ICD-10 code
+// and probability, and the reported evidence with their durations as OBX segments
+func (c Config) ORU(r *infermedica.InterviewResult, o ORUOptions) (Message, error) {
+	if r == nil {
+		return nil, fmt.Errorf("hl7: nil interview result")
+	}
+	if r.ID == "" && (o.ControlID == "" || o.PatientID == "") {
+		return nil, fmt.Errorf("hl7: interview result without ID")
+	}
+	if err := r.Sex.IsValid(); err != nil {
+		return nil, err
+	}
+	if o.ControlID == "" {
+		o.ControlID = r.ID
+	}
+	if o.PatientID == "" {
+		o.PatientID = r.ID
+	}
+	if o.Time.IsZero() {
+		o.Time = time.Now()
+	}
+	processing := c.ProcessingID
+	if processing == "" {
+		processing = DefaultProcessing
+	}
+	version := c.Version
+	if version == "" {
+		version = DefaultVersion
+	}
+	observed := FormatTime(o.Time)
+	if r.EvaluatedAt != "" {
+		t, err := infermedica.ParseTime(r.EvaluatedAt)
+		if err != nil {
+			return nil, err
+		}
+		observed = FormatTime(t)
+	}
+
+	m := Message{
+		{"MSH", EncodingChars, Field(c.SendingApplication), Field(c.SendingFacility), Field(c.ReceivingApplication),
+			Field(c.ReceivingFacility), FormatTime(o.Time), "", Field("ORU", "R01", "ORU_R01"), Field(o.ControlID),
+			Field(processing), Field(version)},
+		{"PID", "1", "", Field(o.PatientID), "", "", "", "", sex(r.Sex)},
+		{"OBR", "1", "", Field(r.ID), Field("interview", "Infermedica interview", CodingSystemLocal), "", "", observed,
+			"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "F"},
+	}
+	b := obxBuilder{observed: observed}
+
+	age := strconv.Itoa(r.Age.Years())
+	unit := Field("a", "year", CodingSystemUCUM)
+	if r.Age.Unit == infermedica.AgeUnitMonth {
+		age, unit = strconv.Itoa(r.Age.Months()), Field("mo", "month", CodingSystemUCUM)
+	}
+	m = append(m, b.obx("NM", Field("30525-0", "Age", CodingSystemLOINC), "", age, unit))
+
+	if r.Triage != nil {
+		m = append(m, b.obx("CWE", Field("triage_level", "Triage level", CodingSystemLocal), "",
+			Field(string(r.Triage.TriageLevel), r.Triage.TriageLevel.String(), CodingSystemLocal), ""))
+		for i, s := range r.Triage.Serious {
+			m = append(m, b.obx("CWE", Field("serious", "Serious observation", CodingSystemLocal), strconv.Itoa(i+1),
+				Field(s.ID, s.CommonName, CodingSystemLocal), ""))
+		}
+	}
+
+	if r.Diagnosis != nil {
+		conditions := r.Diagnosis.Conditions
+		max := c.MaxConditions
+		if max == 0 {
+			max = DefaultConditions
+		}
+		if max > 0 && len(conditions) > max {
+			conditions = conditions[:max]
+		}
+		for i, cond := range conditions {
+			sub := strconv.Itoa(i + 1)
+			m = append(m,
+				b.obx("CWE", Field("condition", "Condition", CodingSystemLocal), sub, conditionCode(cond), ""),
+				b.obx("NM", Field("probability", "Probability", CodingSystemLocal), sub,
+					strconv.FormatFloat(cond.Probability*100, 'f', 1, 64), Field("%", "percent", CodingSystemUCUM)))
+		}
+	}
+
+	for i, e := range r.Evidence {
+		choice, ok := yesNo[e.ChoiceID]
+		if !ok {
+			return nil, fmt.Errorf("hl7: unexpected choice %q for evidence %s", e.ChoiceID, e.ID)
+		}
+		sub := strconv.Itoa(i + 1)
+		code := Field(e.ID, r.Name(e.ID), CodingSystemLocal)
+		m = append(m, b.obx("CWE", code, sub, Field(choice[0], choice[1], CodingSystemYesNo), ""))
+		if e.Duration != nil {
+			u, ok := ucum[e.Duration.Unit]
+			if !ok {
+				return nil, fmt.Errorf("hl7: unexpected duration unit %q for evidence %s", e.Duration.Unit, e.ID)
+			}
+			m = append(m, b.obx("NM", Field("duration", "Duration", CodingSystemLocal), sub,
+				strconv.Itoa(e.Duration.Value), Field(u, string(e.Duration.Unit), CodingSystemUCUM)))
+		}
+	}
+	return m, nil
+}
+
+// obxBuilder numbers the OBX segments of a message
+type obxBuilder struct {
+	n        int
+	observed string
+}
+
+func (b *obxBuilder) obx(valueType, id, subID, value, units string) Segment {
+	b.n++
+	return Segment{"OBX", strconv.Itoa(b.n), valueType, id, subID, value, units, "", "", "", "", "F", "", "", b.observed}
+}
+
+// conditionCode returns the ICD-10 code of the condition with the Infermedica ID as alternate code,
+// or only the Infermedica ID when ConditionDetails was not included
+func conditionCode(c infermedica.Conditions) string {
+	if c.ConditionDetails != nil && c.ConditionDetails.Icd10Code != "" {
+		return Field(c.ConditionDetails.Icd10Code, c.Name, CodingSystemICD10, c.ID, c.Name, CodingSystemLocal)
+	}
+	return Field(c.ID, c.Name, CodingSystemLocal)
+}
+
+// sex returns the administrative sex of PID-8
+func sex(s infermedica.Sex) string {
+	switch s {
+	case infermedica.SexMale:
+		return "M"
+	case infermedica.SexFemale:
+		return "F"
+	}
+	return "U"
+}
@@ -0,0 +1,130 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+var fixtureConfig = Config{
+	SendingApplication:   "Symptom|Checker",
+	SendingFacility:      "Clinic^East",
+	ReceivingApplication: "EHR~2",
+	ReceivingFacility:    `R&D\Lab`,
+}
+
+func fixtureResult() *infermedica.InterviewResult {
+	return &infermedica.InterviewResult{
+		ID:          "iv-1",
+		Sex:         infermedica.SexFemale,
+		Age:         infermedica.Age{Value: 30},
+		EvaluatedAt: "2024-01-01T10:00:00Z",
+		Evidence: []infermedica.Evidence{
+			{ID: "s_21", ChoiceID: infermedica.EvidenceChoiceIDPresent, Duration: &infermedica.Duration{Value: 3, Unit: infermedica.DurationUnitDay}},
+			{ID: "s_98", ChoiceID: infermedica.EvidenceChoiceIDAbsent},
+		},
+		Diagnosis: &infermedica.DiagnosisRes{Conditions: []infermedica.Conditions{
+			{ID: "c_55", Name: "Tension-type headaches", CommonName: "Tension headache", Probability: 0.4321,
+				ConditionDetails: &infermedica.ConditionDetails{Icd10Code: "G44.2"}},
+			{ID: "c_49", Name: "Migraine\r\nwith aura", CommonName: "Migraine", Probability: 0.2},
+		}},
+		Triage: &infermedica.TriageRes{
+			TriageLevel: infermedica.TriageLevelConsultation,
+			Serious:     []infermedica.Serious{{ID: "s_1193", CommonName: "Headache, severe & sudden"}},
+		},
+	}
+}
+
+// fixtureORU is the message of fixtureResult, segments are joined by \r
+var fixtureORU = []string{
+	`MSH|^~\&|Symptom\F\Checker|Clinic\S\East|EHR\R\2|R\T\D\E\Lab|20240102030405+0000||ORU^R01^ORU_R01|iv-1|P|2.5.1`,
+	`PID|1||iv-1|||||F`,
+	`OBR|1||iv-1|interview^Infermedica interview^L|||20240101100000+0000||||||||||||||||||F`,
+	`OBX|1|NM|30525-0^Age^LN||30|a^year^UCUM|||||F|||20240101100000+0000`,
+	`OBX|2|CWE|triage_level^Triage level^L||consultation^consultation^L||||||F|||20240101100000+0000`,
+	`OBX|3|CWE|serious^Serious observation^L|1|s_1193^Headache, severe \T\ sudden^L||||||F|||20240101100000+0000`,
+	`OBX|4|CWE|condition^Condition^L|1|G44.2^Tension-type headaches^I10^c_55^Tension-type headaches^L||||||F|||20240101100000+0000`,
+	`OBX|5|NM|probability^Probability^L|1|43.2|%^percent^UCUM|||||F|||20240101100000+0000`,
+	`OBX|6|CWE|condition^Condition^L|2|c_49^Migraine\X0D\\X0A\with aura^L||||||F|||20240101100000+0000`,
+	`OBX|7|NM|probability^Probability^L|2|20.0|%^percent^UCUM|||||F|||20240101100000+0000`,
+	`OBX|8|CWE|s_21^s_21^L|1|Y^Yes^HL70136||||||F|||20240101100000+0000`,
+	`OBX|9|NM|duration^Duration^L|1|3|d^day^UCUM|||||F|||20240101100000+0000`,
+	`OBX|10|CWE|s_98^s_98^L|2|N^No^HL70136||||||F|||20240101100000+0000`,
+}
+
+func TestORU(t *testing.T) {
+	m, err := fixtureConfig.ORU(fixtureResult(), ORUOptions{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Split(strings.TrimSuffix(m.String(), "\r"), "\r")
+	if len(got) != len(fixtureORU) {
+		t.Fatalf("got %d segments, want %d:\n%s", len(got), len(fixtureORU), strings.Join(got, "\n"))
+	}
+	for i := range fixtureORU {
+		if got[i] != fixtureORU[i] {
+			t.Errorf("segment %d:\ngot  %s\nwant %s", i+1, got[i], fixtureORU[i])
+		}
+	}
+	if !strings.HasSuffix(m.String(), "\r") || strings.Contains(m.String(), "\n") {
+		t.Errorf("segments must be terminated by a carriage return only: %q", m.String())
+	}
+}
+
+func TestORUFieldPositions(t *testing.T) {
+	m, err := fixtureConfig.ORU(fixtureResult(), ORUOptions{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// field returns a field by its HL7 position, MSH-1 is the field separator itself
+	field := func(s Segment, n int) string {
+		if s[0] == "MSH" {
+			n--
+		}
+		if n >= len(s) {
+			return ""
+		}
+		return s[n]
+	}
+	tests := []struct {
+		segment int
+		name    string
+		n       int
+		want    string
+	}{
+		{0, "MSH-2", 2, `^~\&`},
+		{0, "MSH-7", 7, "20240102030405+0000"},
+		{0, "MSH-9", 9, "ORU^R01^ORU_R01"},
+		{0, "MSH-10", 10, "iv-1"},
+		{0, "MSH-12", 12, "2.5.1"},
+		{1, "PID-3", 3, "iv-1"},
+		{1, "PID-8", 8, "F"},
+		{2, "OBR-7", 7, "20240101100000+0000"},
+		{2, "OBR-25", 25, "F"},
+		{3, "OBX-2", 2, "NM"},
+		{3, "OBX-5", 5, "30"},
+		{3, "OBX-11", 11, "F"},
+		{3, "OBX-14", 14, "20240101100000+0000"},
+		{12, "OBX-11", 11, "F"},
+		{12, "OBX-14", 14, "20240101100000+0000"},
+	}
+	for _, tt := range tests {
+		if got := field(m[tt.segment], tt.n); got != tt.want {
+			t.Errorf("%s of segment %d = %q, want %q", tt.name, tt.segment+1, got, tt.want)
+		}
+	}
+}
+
+func TestORUErrors(t *testing.T) {
+	unknown := fixtureResult()
+	unknown.Evidence = []infermedica.Evidence{{ID: "s_1", ChoiceID: "maybe"}}
+	noID := fixtureResult()
+	noID.ID = ""
+	for name, r := range map[string]*infermedica.InterviewResult{"nil": nil, "unknown choice": unknown, "no ID": noID} {
+		if _, err := fixtureConfig.ORU(r, ORUOptions{}); err == nil {
+			t.Errorf("%s: ORU returned no error", name)
+		}
+	}
+}
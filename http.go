@@ -11,62 +11,74 @@ type Response struct {
 	Message string `json:"message"`
 }
 
-// Check response status, returns an error with the status code if it is not 200
+// APIError is returned when the API answers with a status other than 200
+type APIError struct {
+	StatusCode int
+	Status     string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("infermedica: %s: %s", e.Status, e.Message)
+}
+
+// Check response status, returns an *APIError with the status code if it is not 200
 func checkResponse(res *http.Response) error {
 	if res.StatusCode != http.StatusOK {
 		var response Response
 		json.NewDecoder(res.Body).Decode(&response)
-		return fmt.Errorf("infermedica: %s: %s", res.Status, response.Message)
+		return &APIError{StatusCode: res.StatusCode, Status: res.Status, Message: response.Message}
 	}
 	return nil
 }
 
-func (a *App) prepareRequest(method, url string, body interface{}) (*http.Request, error) {
+func (a *App) prepareRequest(method, url string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	switch method {
 	case "GET":
-		return a.prepareGETRequest(url)
+		return a.prepareGETRequest(url, opts...)
 	case "POST":
-		return a.preparePOSTRequest(url, body)
+		return a.preparePOSTRequest(url, body, opts...)
 	}
 	return nil, fmt.Errorf("infermedica: method not allowed")
 }
 
-func (a *App) addHeaders(req *http.Request) {
+func (a *App) addHeaders(req *http.Request, opts []RequestOption) {
+	o := a.requestOptions(opts)
 	req.Header.Add("App-Id", a.appID)
 	req.Header.Add("App-Key", a.appKey)
 	req.Header.Add("Content-Type", "application/json")
-	if a.devMode {
+	if o.devMode {
 		req.Header.Add("Dev-Mode", "true")
 	}
-	if a.model != "" {
-		req.Header.Add("Model", a.model)
+	if model := o.headerModel(); model != "" {
+		req.Header.Add("Model", string(model))
 	}
-	if a.interviewID != "" {
-		req.Header.Add("Interview-Id", a.interviewID)
+	if o.interviewID != "" {
+		req.Header.Add("Interview-Id", o.interviewID)
 	}
 }
 
-func (a *App) prepareGETRequest(url string) (*http.Request, error) {
+func (a *App) prepareGETRequest(url string, opts ...RequestOption) (*http.Request, error) {
 	baseURL := a.baseURL
-	req, err := http.NewRequest("GET", baseURL+url, nil)
+	req, err := http.NewRequestWithContext(a.requestOptions(opts).ctx, "GET", baseURL+url, nil)
 	if err != nil {
 		return nil, err
 	}
-	a.addHeaders(req)
+	a.addHeaders(req, opts)
 	return req, nil
 }
 
-func (a *App) preparePOSTRequest(url string, body interface{}) (*http.Request, error) {
+func (a *App) preparePOSTRequest(url string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	b := new(bytes.Buffer)
 	err := json.NewEncoder(b).Encode(body)
 	if err != nil {
 		return nil, err
 	}
 	baseURL := a.baseURL
-	req, err := http.NewRequest("POST", baseURL+url, b)
+	req, err := http.NewRequestWithContext(a.requestOptions(opts).ctx, "POST", baseURL+url, b)
 	if err != nil {
 		return nil, err
 	}
-	a.addHeaders(req)
+	a.addHeaders(req, opts)
 	return req, nil
 }
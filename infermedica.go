@@ -5,30 +5,57 @@ import (
 	"strings"
 )
 
+// App is an Infermedica API client. Apart from the deprecated EnableDevMode and DisableDevMode it is never
+// modified after NewApp, so the same App can be shared by many goroutines; use RequestOption values to change
+// the headers of a single call
 type App struct {
 	baseURL     string
 	appID       string
 	appKey      string
-	model       string
+	model       Model
 	interviewID string
+	language    Language
 	devMode     bool
+
+	unknownFields  bool
+	strictDecoding bool
 }
 
-func NewApp(id, key, model, interviewID string) App {
-	return App{
-		baseURL:     "https://api.infermedica.com/v3/",
-		appID:       id,
-		appKey:      key,
+// NewApp returns a new App, opts set the default headers of every request
+func NewApp(id, key string, model Model, interviewID string, opts ...RequestOption) App {
+	a := App{
+		baseURL: "https://api.infermedica.com/v3/",
+		appID:   id,
+		appKey:  key,
+	}
+	o := requestOptions{
 		model:       model,
 		interviewID: interviewID,
 	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	a.model = o.model
+	a.interviewID = o.interviewID
+	a.language = o.language
+	a.devMode = o.devMode
+	a.unknownFields = o.unknownFields
+	a.strictDecoding = o.strictDecoding
+	return a
 }
 
-// EnableDevMode
+// EnableDevMode enables the dev mode for every request of the App.
+//
+// Deprecated: EnableDevMode modifies the App, so it is not safe while the App is used by other goroutines;
+// use WithDevMode(true) in NewApp or in a single call instead
 func (a *App) EnableDevMode() {
 	a.devMode = true
 }
 
+// DisableDevMode disables the dev mode for every request of the App.
+//
+// Deprecated: DisableDevMode modifies the App, so it is not safe while the App is used by other goroutines;
+// use WithDevMode(false) in NewApp or in a single call instead
 func (a *App) DisableDevMode() {
 	a.devMode = false
 }
@@ -88,6 +115,14 @@ func SexFilterFromString(x string) (SexFilter, error) {
 	}
 }
 
+func (s SexFilter) String() string {
+	return string(s)
+}
+
+func (s *SexFilter) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, s, SexFilterFromString)
+}
+
 type EvidenceChoiceID string
 
 const (
@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"net/http"
 	"time"
 )
@@ -13,10 +12,11 @@ type InfoRes struct {
 	SymptomsCount    int       `json:"symptoms_count"`
 	RiskFactorsCount int       `json:"risk_factors_count"`
 	LabTestsCount    int       `json:"lab_tests_count"`
+	Unknown          Unknown   `json:"-"` // Only when WithUnknownFields is used
 }
 
-func (a *App) Info() (*InfoRes, error) {
-	req, err := a.prepareRequest("GET", "info", nil)
+func (a *App) Info(opts ...RequestOption) (*InfoRes, error) {
+	req, err := a.prepareRequest("GET", "info", nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +35,7 @@ func (a *App) Info() (*InfoRes, error) {
 		return nil, err
 	}
 	var r InfoRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,103 @@
+package infermedica
+
+import "sync"
+
+// Intake accumulates the evidence found by Parse across several free text messages of the same patient.
+// A later message overrides the choice of an earlier one, so "actually no fever" turns fever absent
+type Intake struct {
+	app      *App
+	sex      Sex
+	age      Age
+	mu       sync.Mutex
+	messages []IntakeMessage
+	evidence []Evidence
+	origins  map[string]int // Evidence ID to the index of the last message that mentioned it
+}
+
+// IntakeMessage is a message given to the Intake and the result of parsing it
+type IntakeMessage struct {
+	Text     string
+	Response ParseRes
+}
+
+// NewIntake returns an empty Intake for a patient
+func NewIntake(app *App, sex Sex, age Age) *Intake {
+	return &Intake{
+		app:     app,
+		sex:     sex,
+		age:     age,
+		origins: map[string]int{},
+	}
+}
+
+// Add parses a message and merges its mentions into the accumulated evidence
+func (in *Intake) Add(text string, opts ...RequestOption) (*ParseRes, error) {
+	res, err := in.app.Parse(ParseReq{
+		Text:            text,
+		Age:             in.age,
+		Sex:             in.sex,
+		CorrectSpelling: true,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.messages = append(in.messages, IntakeMessage{Text: text, Response: *res})
+	in.evidence = res.ToEvidence(ParseEvidenceOptions{
+		Conflict: ConflictRulePreferLast,
+		Existing: in.evidence,
+	})
+	for _, m := range res.Mentions {
+		in.origins[m.ID] = len(in.messages) - 1
+	}
+	return res, nil
+}
+
+// Evidence returns a copy of the accumulated evidence, marked as initial
+func (in *Intake) Evidence() []Evidence {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return append([]Evidence(nil), in.evidence...)
+}
+
+// Messages returns a copy of the messages given to the Intake
+func (in *Intake) Messages() []IntakeMessage {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return append([]IntakeMessage(nil), in.messages...)
+}
+
+// Origin returns the last message that mentioned the evidence
+func (in *Intake) Origin(id string) (IntakeMessage, bool) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	i, ok := in.origins[id]
+	if !ok {
+		return IntakeMessage{}, false
+	}
+	return in.messages[i], true
+}
+
+// Obvious reports whether the evidence comes from a message that Parse marked as obvious,
+// such evidence does not need a confirmation question
+func (in *Intake) Obvious(id string) bool {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	i, ok := in.origins[id]
+	return ok && in.messages[i].Response.Obvious
+}
+
+// Unconfirmed returns the evidence that came from messages that were not obvious
+func (in *Intake) Unconfirmed() []Evidence {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	var unconfirmed []Evidence
+	for _, e := range in.evidence {
+		if i, ok := in.origins[e.ID]; ok && !in.messages[i].Response.Obvious {
+			unconfirmed = append(unconfirmed, e)
+		}
+	}
+	return unconfirmed
+}
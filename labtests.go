@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -26,6 +25,7 @@ type LabTestsRes struct {
 	CommonName string      `json:"common_name"`
 	Category   string      `json:"category"`
 	Results    []LabResult `json:"results"`
+	Unknown    Unknown     `json:"-"` // Only when WithUnknownFields is used
 }
 
 type LabResult struct {
@@ -33,8 +33,8 @@ type LabResult struct {
 	Type string `json:"type"`
 }
 
-func (a *App) LabTests(age Age, enableTriage3 bool) (*[]LabTestsRes, error) {
-	req, err := a.prepareRequest("GET", "lab_tests?age.value="+strconv.Itoa(age.Value)+"&age.unit"+string(age.Unit)+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil)
+func (a *App) LabTests(age Age, enableTriage3 bool, opts ...RequestOption) (*[]LabTestsRes, error) {
+	req, err := a.prepareRequest("GET", "lab_tests?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,15 +53,15 @@ func (a *App) LabTests(age Age, enableTriage3 bool) (*[]LabTestsRes, error) {
 		return nil, err
 	}
 	var r []LabTestsRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-func (a *App) LabTestByID(id string, age Age, enableTriage3 bool) (*LabTestsRes, error) {
-	req, err := a.prepareRequest("GET", "lab_tests/"+id+"?age.value="+strconv.Itoa(age.Value)+"&age.unit"+string(age.Unit)+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil)
+func (a *App) LabTestByID(id string, age Age, enableTriage3 bool, opts ...RequestOption) (*LabTestsRes, error) {
+	req, err := a.prepareRequest("GET", "lab_tests/"+id+"?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +71,7 @@ func (a *App) LabTestByID(id string, age Age, enableTriage3 bool) (*LabTestsRes,
 		return nil, err
 	}
 	var r LabTestsRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +81,7 @@ func (a *App) LabTestByID(id string, age Age, enableTriage3 bool) (*LabTestsRes,
 type LabTestsRecommendRes struct {
 	Recommended []LabTestsRecommendation `json:"recommended"`
 	Obligatory  []LabTestsRecommendation `json:"obligatory"`
+	Unknown     Unknown                  `json:"-"` // Only when WithUnknownFields is used
 }
 type LabTestsRecommendation struct {
 	PanelID  string       `json:"panel_id"`
@@ -93,11 +94,17 @@ type LabTestsID struct {
 }
 
 // Recommend is a func to request lab test recommendations for given data
-func (a *App) LabTestsRecommend(dr LabTestsReq) (*LabTestsRecommendRes, error) {
+func (a *App) LabTestsRecommend(dr LabTestsReq, opts ...RequestOption) (*LabTestsRecommendRes, error) {
 	if dr.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: Unexpected value for Sex")
 	}
-	req, err := a.prepareRequest("POST", "lab_tests/recommend", dr)
+	if dr.EvaluatedAt != "" {
+		err := ValidateTimeline(dr.EvaluatedAt, dr.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "lab_tests/recommend", dr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +114,7 @@ func (a *App) LabTestsRecommend(dr LabTestsReq) (*LabTestsRecommendRes, error) {
 		return nil, err
 	}
 	var r LabTestsRecommendRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,124 @@
+package infermedica
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Language is a language code supported by the Infermedica models
+type Language string
+
+const (
+	LanguageEnglish    Language = "en"
+	LanguagePolish     Language = "pl"
+	LanguageGerman     Language = "de"
+	LanguageFrench     Language = "fr"
+	LanguageSpanish    Language = "es"
+	LanguageItalian    Language = "it"
+	LanguagePortuguese Language = "pt"
+	LanguageDutch      Language = "nl"
+	LanguageCzech      Language = "cs"
+	LanguageSlovak     Language = "sk"
+	LanguageRussian    Language = "ru"
+	LanguageUkrainian  Language = "uk"
+	LanguageArabic     Language = "ar"
+	LanguageTurkish    Language = "tr"
+)
+
+// Model is the value sent in the Model header, it selects the knowledge base language
+type Model string
+
+const (
+	ModelEnglish    Model = "infermedica-en"
+	ModelPolish     Model = "infermedica-pl"
+	ModelGerman     Model = "infermedica-de"
+	ModelFrench     Model = "infermedica-fr"
+	ModelSpanish    Model = "infermedica-es"
+	ModelItalian    Model = "infermedica-it"
+	ModelPortuguese Model = "infermedica-pt"
+	ModelDutch      Model = "infermedica-nl"
+	ModelCzech      Model = "infermedica-cs"
+	ModelSlovak     Model = "infermedica-sk"
+	ModelRussian    Model = "infermedica-ru"
+	ModelUkrainian  Model = "infermedica-uk"
+	ModelArabic     Model = "infermedica-ar"
+	ModelTurkish    Model = "infermedica-tr"
+)
+
+// models is the registry of known models by language
+var models = map[Language]Model{
+	LanguageEnglish:    ModelEnglish,
+	LanguagePolish:     ModelPolish,
+	LanguageGerman:     ModelGerman,
+	LanguageFrench:     ModelFrench,
+	LanguageSpanish:    ModelSpanish,
+	LanguageItalian:    ModelItalian,
+	LanguagePortuguese: ModelPortuguese,
+	LanguageDutch:      ModelDutch,
+	LanguageCzech:      ModelCzech,
+	LanguageSlovak:     ModelSlovak,
+	LanguageRussian:    ModelRussian,
+	LanguageUkrainian:  ModelUkrainian,
+	LanguageArabic:     ModelArabic,
+	LanguageTurkish:    ModelTurkish,
+}
+
+func (l Language) IsValid() error {
+	_, err := LanguageFromString(string(l))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func LanguageFromString(x string) (Language, error) {
+	l := Language(strings.ToLower(x))
+	if _, ok := models[l]; !ok {
+		return "", fmt.Errorf("infermedica: unexpected value for Language: %q", x)
+	}
+	return l, nil
+}
+
+// Model returns the model of the language, unknown languages follow the "infermedica-<language>" convention
+func (l Language) Model() Model {
+	if m, ok := models[l]; ok {
+		return m
+	}
+	return Model("infermedica-" + string(l))
+}
+
+func (m Model) IsValid() error {
+	_, err := ModelFromString(string(m))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func ModelFromString(x string) (Model, error) {
+	m := Model(strings.ToLower(x))
+	for _, model := range models {
+		if m == model {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("infermedica: unexpected value for Model: %q", x)
+}
+
+// Language returns the language of the model, an empty model is the API default (english)
+func (m Model) Language() Language {
+	if m == "" {
+		return LanguageEnglish
+	}
+	for l, model := range models {
+		if m == model {
+			return l
+		}
+	}
+	return Language(strings.TrimPrefix(string(m), "infermedica-"))
+}
+
+// SupportsParse reports whether the model can be used with Parse, NLP is only avaliable in english at the moment
+func (m Model) SupportsParse() bool {
+	return m.Language() == LanguageEnglish
+}
@@ -0,0 +1,96 @@
+package infermedica
+
+import "context"
+
+// RequestOption overrides the headers of a single request without changing the App
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	ctx         context.Context
+	model       Model
+	interviewID string
+	language    Language
+	devMode     bool
+
+	unknownFields  bool
+	strictDecoding bool
+}
+
+// WithContext sets the context of a single request, canceling it cancels the request
+func WithContext(ctx context.Context) RequestOption {
+	return func(o *requestOptions) {
+		o.ctx = ctx
+	}
+}
+
+// WithModel sets the Model header for a single request
+func WithModel(model Model) RequestOption {
+	return func(o *requestOptions) {
+		o.model = model
+		o.language = ""
+	}
+}
+
+// WithInterviewID sets the Interview-Id header for a single request
+func WithInterviewID(interviewID string) RequestOption {
+	return func(o *requestOptions) {
+		o.interviewID = interviewID
+	}
+}
+
+// WithDevMode enables or disables the Dev-Mode header for a single request
+func WithDevMode(enabled bool) RequestOption {
+	return func(o *requestOptions) {
+		o.devMode = enabled
+	}
+}
+
+// WithLanguage selects the model of the language (e.g. LanguagePolish uses ModelPolish) for a single request,
+// replacing any model set before it
+func WithLanguage(language Language) RequestOption {
+	return func(o *requestOptions) {
+		o.model = ""
+		o.language = language
+	}
+}
+
+// WithUnknownFields keeps the response fields the library does not model in the Unknown field of the response
+func WithUnknownFields(enabled bool) RequestOption {
+	return func(o *requestOptions) {
+		o.unknownFields = enabled
+	}
+}
+
+// WithStrictDecoding makes a request fail when the response has fields the library does not model,
+// it is meant for tests that detect API changes
+func WithStrictDecoding(enabled bool) RequestOption {
+	return func(o *requestOptions) {
+		o.strictDecoding = enabled
+	}
+}
+
+// requestOptions merges the App defaults with the given per-call options
+func (a *App) requestOptions(opts []RequestOption) requestOptions {
+	o := requestOptions{
+		ctx:         context.Background(),
+		model:       a.model,
+		interviewID: a.interviewID,
+		language:    a.language,
+		devMode:     a.devMode,
+
+		unknownFields:  a.unknownFields,
+		strictDecoding: a.strictDecoding,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// headerModel returns the value of the Model header
+func (o requestOptions) headerModel() Model {
+	if o.model != "" || o.language == "" {
+		return o.model
+	}
+	return o.language.Model()
+}
@@ -1,9 +1,9 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -16,29 +16,75 @@ type ParseReq struct {
 }
 
 type ParseRes struct {
-	Mentions []struct {
-		ID         string `json:"id"`
-		Orth       string `json:"orth"`
-		ChoiceID   string `json:"choice_id"`
-		Name       string `json:"name"`
-		CommonName string `json:"common_name"`
-		Type       string `json:"type"`
-	} `json:"mentions"`
-	Tokens  []string `json:"tokens"`
-	Obvious bool     `json:"obvious"`
+	Mentions []Mention `json:"mentions"`
+	Tokens   []string  `json:"tokens"` // Only when IncludeTokens is true
+	Obvious  bool      `json:"obvious"`
+	Unknown  Unknown   `json:"-"` // Only when WithUnknownFields is used
 }
 
-// Parse returns a list of all the mentions of observation found in given text
-func (a *App) Parse(pr ParseReq) (*ParseRes, error) {
-	// Required to use "infermedica-en" model, because NPL is only avaliable in english at the moment
-	model := a.model
-	a.model = ""
+// Mention is an observation recognized in the parsed text
+type Mention struct {
+	ID           string           `json:"id"`
+	Orth         string           `json:"orth"` // The fragment of the text that was recognized
+	ChoiceID     EvidenceChoiceID `json:"choice_id"`
+	Name         string           `json:"name"`
+	CommonName   string           `json:"common_name"`
+	Type         MentionType      `json:"type"`
+	Positions    []int            `json:"positions,omitempty"`     // Indexes in Tokens of the recognized words, only when IncludeTokens is true
+	HeadPosition *int             `json:"head_position,omitempty"` // Index in Tokens of the head word, only when IncludeTokens is true
+}
+
+type MentionType string
+
+const (
+	MentionTypeSymptom    MentionType = "symptom"
+	MentionTypeRiskFactor MentionType = "risk_factor"
+)
+
+func (mt *MentionType) IsValid() error {
+	_, err := MentionTypeFromString(string(*mt))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func MentionTypeFromString(x string) (MentionType, error) {
+	switch strings.ToLower(x) {
+	case "symptom":
+		return MentionTypeSymptom, nil
+	case "risk_factor":
+		return MentionTypeRiskFactor, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for mention type: %q", x)
+	}
+}
+
+// Negated reports whether the text denies the observation (e.g. "no fever")
+func (m *Mention) Negated() bool {
+	return m.ChoiceID == EvidenceChoiceIDAbsent
+}
+
+// Head returns the head word of the mention, it requires IncludeTokens
+func (p *ParseRes) Head(m Mention) (string, bool) {
+	if m.HeadPosition == nil || *m.HeadPosition < 0 || *m.HeadPosition >= len(p.Tokens) {
+		return "", false
+	}
+	return p.Tokens[*m.HeadPosition], true
+}
 
-	req, err := a.preparePOSTRequest("parse", pr)
+// Parse returns a list of all the mentions of observation found in given text.
+// The text is always parsed with an english model, so an interview can be held in another language
+// (same Interview-Id) while the patient free text is parsed in english
+func (a *App) Parse(pr ParseReq, opts ...RequestOption) (*ParseRes, error) {
+	// Required to use "infermedica-en" model, because NPL is only avaliable in english at the moment
+	if !a.requestOptions(opts).headerModel().SupportsParse() {
+		opts = append(opts[:len(opts):len(opts)], WithModel(ModelEnglish))
+	}
+	req, err := a.preparePOSTRequest("parse", pr, opts...)
 	if err != nil {
 		return nil, err
 	}
-	a.model = model
 	client := &http.Client{
 		Timeout: time.Second * 5,
 	}
@@ -54,25 +100,60 @@ func (a *App) Parse(pr ParseReq) (*ParseRes, error) {
 		return nil, err
 	}
 	var r ParseRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-// Converts a Parse Response into an Evidence
+// ParseEvidenceOptions configures the conversion of a Parse Response into Evidence
+type ParseEvidenceOptions struct {
+	Source   EvidenceSource // Source of the evidence, EvidenceSourceInitial when empty
+	Conflict ConflictRule   // How a mention that is both present and absent is resolved, ConflictRulePreferPresent when empty
+	Types    []MentionType  // Only mentions of these types are converted, all types when empty
+	Existing []Evidence     // Evidence the mentions are merged into
+}
+
+// Converts a Parse Response into an Evidence, marked as initial and without duplicates.
+// It returns an empty slice when nothing was recognized, the error is always nil
 func (p *ParseRes) ParseToEvidence() (evidences []Evidence, err error) {
-	var e Evidence
+	evidences = p.ToEvidence(ParseEvidenceOptions{})
+	if evidences == nil {
+		evidences = []Evidence{}
+	}
+	return evidences, nil
+}
 
-	if len(p.Mentions) == 0 {
-		return nil, fmt.Errorf("infermedica: empty mentions")
+// ToEvidence converts the mentions into Evidence, merging duplicates and resolving conflicts.
+// It returns Existing unchanged when no mention matches
+func (p *ParseRes) ToEvidence(o ParseEvidenceOptions) []Evidence {
+	source := o.Source
+	if source == "" {
+		source = EvidenceSourceInitial
 	}
+	var evidences []Evidence
+	for _, m := range p.Mentions {
+		if !o.includes(m.Type) {
+			continue
+		}
+		evidences = append(evidences, Evidence{
+			ID:       m.ID,
+			ChoiceID: m.ChoiceID,
+			Source:   source,
+		})
+	}
+	return MergeEvidence(o.Existing, evidences, o.Conflict)
+}
 
-	for i := range p.Mentions {
-		e.ChoiceID = EvidenceChoiceID(p.Mentions[i].ChoiceID)
-		e.ID = p.Mentions[i].ID
-		evidences = append(evidences, e)
+func (o ParseEvidenceOptions) includes(t MentionType) bool {
+	if len(o.Types) == 0 {
+		return true
+	}
+	for _, x := range o.Types {
+		if x == t {
+			return true
+		}
 	}
-	return
+	return false
 }
@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -23,6 +22,7 @@ type RationaleRes struct {
 	Type              RationaleType       `json:"type"`
 	ObservationParams []ObservationParams `json:"observation_params"`
 	ConditionParams   []ConditionParams   `json:"condition_params"`
+	Unknown           Unknown             `json:"-"` // Only when WithUnknownFields is used
 }
 type ObservationParams struct {
 	ID         string `json:"id"`
@@ -48,11 +48,17 @@ const (
 )
 
 // Rationale returns the rationale behind the questions that are asked by the system
-func (a *App) Rationale(sr RationaleReq) (*[]RationaleRes, error) {
+func (a *App) Rationale(sr RationaleReq, opts ...RequestOption) (*[]RationaleRes, error) {
 	if sr.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: Unexpected value for Sex")
 	}
-	req, err := a.prepareRequest("POST", "Rationale", sr)
+	if sr.EvaluatedAt != "" {
+		err := ValidateTimeline(sr.EvaluatedAt, sr.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "Rationale", sr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +78,7 @@ func (a *App) Rationale(sr RationaleReq) (*[]RationaleRes, error) {
 	}
 
 	var r []RationaleRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,9 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -25,7 +25,8 @@ type RecommendSpecialistRes struct {
 		Id   string `json:"id"`
 		Name string `json:"name"`
 	} `json:"recommended_specialist"`
-	RecommendedChannel string `json:"recommended_channel"`
+	RecommendedChannel RecommendedChannel `json:"recommended_channel"`
+	Unknown            Unknown            `json:"-"` // Only when WithUnknownFields is used
 }
 
 type RecommendedChannel string
@@ -37,11 +38,48 @@ const (
 	RecommendedChannelTextTeleconsultation  RecommendedChannel = "text_teleconsultation"
 )
 
-func (a *App) RecommendSpecialist(tr RecommendSpecialistReq) (*RecommendSpecialistRes, error) {
+func (rc *RecommendedChannel) IsValid() error {
+	_, err := RecommendedChannelFromString(string(*rc))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func RecommendedChannelFromString(x string) (RecommendedChannel, error) {
+	switch strings.ToLower(x) {
+	case "personal_visit":
+		return RecommendedChannelPersonalVisit, nil
+	case "video_teleconsultation":
+		return RecommendedChannelVideoTeleconsultation, nil
+	case "audio_teleconsultation":
+		return RecommendedChannelAudioTeleconsultation, nil
+	case "text_teleconsultation":
+		return RecommendedChannelTextTeleconsultation, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for recommended channel: %q", x)
+	}
+}
+
+func (rc RecommendedChannel) String() string {
+	return string(rc)
+}
+
+func (rc *RecommendedChannel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, rc, RecommendedChannelFromString)
+}
+
+func (a *App) RecommendSpecialist(tr RecommendSpecialistReq, opts ...RequestOption) (*RecommendSpecialistRes, error) {
 	if tr.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: unexpected value for Sex")
 	}
-	req, err := a.prepareRequest("POST", "recommend_specialist", tr)
+	if tr.EvaluatedAt != "" {
+		err := ValidateTimeline(tr.EvaluatedAt, tr.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "recommend_specialist", tr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +99,7 @@ func (a *App) RecommendSpecialist(tr RecommendSpecialistReq) (*RecommendSpeciali
 	}
 
 	var r RecommendSpecialistRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,99 @@
+package infermedica
+
+import "fmt"
+
+// RedFlagScreening is the red flag check that follows the initial evidence: the suggested red flags are
+// asked as a single multiple choice question and any present red flag ends the interview with an emergency triage
+type RedFlagScreening struct {
+	Flags []SuggestRes
+}
+
+// ScreenRedFlags requests the red flags related to the initial evidence
+func (a *App) ScreenRedFlags(sex Sex, age Age, evidences []Evidence, opts ...RequestOption) (*RedFlagScreening, error) {
+	flags, err := a.Suggest(SuggestReq{
+		Sex:           sex,
+		Age:           age,
+		Evidences:     evidences,
+		SuggestMethod: SuggestMethodRedFlags,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &RedFlagScreening{Flags: *flags}, nil
+}
+
+// Question presents the red flags as a group_multiple question, the patient selects the ones that are present
+func (s *RedFlagScreening) Question(text string) Question {
+	q := Question{
+		Type: QuestionTypeGroupMultiple,
+		Text: text,
+	}
+	for _, f := range s.Flags {
+		q.Items = append(q.Items, QuestionItem{
+			ID:   f.ID,
+			Name: f.CommonName,
+			Choices: []QuestionItemChoice{
+				{ID: EvidenceChoiceIDPresent, Label: "Yes"},
+				{ID: EvidenceChoiceIDAbsent, Label: "No"},
+			},
+			Explication: f.Explication,
+			Instruction: f.Instruction,
+		})
+	}
+	return q
+}
+
+// Answer records the selected red flags as present and the others as absent, with the red_flags source.
+// It returns the evidence merged into evidences and whether any red flag is present
+func (s *RedFlagScreening) Answer(evidences []Evidence, selected []string) ([]Evidence, bool, error) {
+	present := map[string]bool{}
+	for _, id := range selected {
+		if !s.has(id) {
+			return nil, false, fmt.Errorf("infermedica: unexpected red flag: %q", id)
+		}
+		present[id] = true
+	}
+	answers := make([]Evidence, 0, len(s.Flags))
+	for _, f := range s.Flags {
+		choice := EvidenceChoiceIDAbsent
+		if present[f.ID] {
+			choice = EvidenceChoiceIDPresent
+		}
+		answers = append(answers, Evidence{
+			ID:       f.ID,
+			ChoiceID: choice,
+			Source:   EvidenceSourceRedFlags,
+		})
+	}
+	return MergeEvidence(evidences, answers, ConflictRulePreferLast), len(present) > 0, nil
+}
+
+// RedFlagTriage is the outcome of a screening with present red flags. It is derived locally from the answers,
+// not returned by the API, so it is a distinct type from TriageRes: call Triage with the evidence when the
+// assessment of the API is needed
+type RedFlagTriage struct {
+	TriageLevel TriageLevel  // Always emergency, a present red flag requires at least an emergency visit
+	Flags       []SuggestRes // The red flags reported as present
+}
+
+// EmergencyTriage returns the outcome of a case with present red flags without calling the API
+func (s *RedFlagScreening) EmergencyTriage(selected []string) *RedFlagTriage {
+	t := RedFlagTriage{TriageLevel: TriageLevelEmergency}
+	for _, id := range selected {
+		for _, f := range s.Flags {
+			if f.ID == id {
+				t.Flags = append(t.Flags, f)
+			}
+		}
+	}
+	return &t
+}
+
+func (s *RedFlagScreening) has(id string) bool {
+	for _, f := range s.Flags {
+		if f.ID == id {
+			return true
+		}
+	}
+	return false
+}
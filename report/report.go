@@ -0,0 +1,274 @@
+// Package report renders a finished interview as a clinical summary in Markdown, HTML or plain text.
+package report
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+	FormatText     Format = "text"
+)
+
+func (f *Format) IsValid() error {
+	_, err := FormatFromString(string(*f))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func FormatFromString(x string) (Format, error) {
+	switch strings.ToLower(x) {
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "html":
+		return FormatHTML, nil
+	case "text", "txt":
+		return FormatText, nil
+	default:
+		return "", fmt.Errorf("report: unexpected value for format: %q", x)
+	}
+}
+
+const (
+	DefaultConditions = 5
+	DefaultBarWidth   = 20
+)
+
+// Builder renders summaries, the zero value uses the default templates
+type Builder struct {
+	MaxConditions int               // DefaultConditions when zero, all when negative
+	BarWidth      int               // Width of the probability bars, DefaultBarWidth when zero
+	Templates     map[Format]string // Templates replacing the defaults, executed with a Summary
+}
+
+// Summary is the data of the templates
+type Summary struct {
+	InterviewID string
+	EvaluatedAt string
+	Sex         string
+	Age         string
+	Present     []Observation // Present symptoms
+	Absent      []Observation // Absent symptoms
+	Unknown     []Observation // Symptoms the patient did not know
+	RiskFactors []Observation // Present risk factors
+	Conditions  []Condition
+	Triage      *Triage
+	Specialist  string
+	Channel     string
+}
+
+type Observation struct {
+	ID       string
+	Name     string
+	Duration string // Empty when the evidence has no duration
+}
+
+type Condition struct {
+	ID          string
+	Name        string
+	CommonName  string
+	Icd10Code   string
+	Probability float64
+	Percent     string // Probability as a percentage, e.g. "42%"
+	Bar         string // Probability bar, e.g. "████████░░░░"
+	Hint        string
+}
+
+type Triage struct {
+	Level       string
+	Label       string
+	IsEmergency bool
+	Serious     []Serious
+}
+
+type Serious struct {
+	ID          string
+	Name        string
+	Seriousness string
+	IsEmergency bool
+}
+
+// Summarize returns the data of the summary of a finished interview
+func (b Builder) Summarize(r *infermedica.InterviewResult) (*Summary, error) {
+	if r == nil {
+		return nil, fmt.Errorf("report: nil interview result")
+	}
+	s := &Summary{
+		InterviewID: r.ID,
+		EvaluatedAt: r.EvaluatedAt,
+		Sex:         label(string(r.Sex)),
+		Age:         age(r.Age),
+	}
+	for _, e := range r.Evidence {
+		o := Observation{ID: e.ID, Name: r.Name(e.ID)}
+		if e.Duration != nil {
+			o.Duration = duration(*e.Duration)
+		}
+		isRiskFactor := strings.HasPrefix(e.ID, "p_")
+		switch e.ChoiceID {
+		case infermedica.EvidenceChoiceIDPresent:
+			if isRiskFactor {
+				s.RiskFactors = append(s.RiskFactors, o)
+			} else {
+				s.Present = append(s.Present, o)
+			}
+		case infermedica.EvidenceChoiceIDAbsent:
+			if !isRiskFactor {
+				s.Absent = append(s.Absent, o)
+			}
+		case infermedica.EvidenceChoiceIDUnknown:
+			if !isRiskFactor {
+				s.Unknown = append(s.Unknown, o)
+			}
+		default:
+			return nil, fmt.Errorf("report: unexpected choice %q for evidence %s", e.ChoiceID, e.ID)
+		}
+	}
+	if r.Diagnosis != nil {
+		conditions := r.Diagnosis.Conditions
+		max := b.MaxConditions
+		if max == 0 {
+			max = DefaultConditions
+		}
+		if max > 0 && len(conditions) > max {
+			conditions = conditions[:max]
+		}
+		width := b.BarWidth
+		if width <= 0 {
+			width = DefaultBarWidth
+		}
+		for _, c := range conditions {
+			cond := Condition{
+				ID:          c.ID,
+				Name:        c.Name,
+				CommonName:  c.CommonName,
+				Probability: c.Probability,
+				Percent:     strconv.Itoa(int(c.Probability*100+0.5)) + "%",
+				Bar:         bar(c.Probability, width),
+			}
+			if c.ConditionDetails != nil {
+				cond.Icd10Code = c.ConditionDetails.Icd10Code
+				cond.Hint = c.ConditionDetails.Hint
+			}
+			s.Conditions = append(s.Conditions, cond)
+		}
+	}
+	if r.Triage != nil {
+		t := &Triage{
+			Level:       string(r.Triage.TriageLevel),
+			Label:       label(string(r.Triage.TriageLevel)),
+			IsEmergency: r.Triage.TriageLevel.Compare(infermedica.TriageLevelEmergency) >= 0,
+		}
+		for _, sr := range r.Triage.Serious {
+			name := sr.CommonName
+			if name == "" {
+				name = r.Name(sr.ID)
+			}
+			t.Serious = append(t.Serious, Serious{
+				ID:          sr.ID,
+				Name:        name,
+				Seriousness: label(string(sr.Seriousness)),
+				IsEmergency: sr.Seriousness.Compare(infermedica.SeriousnessSeriousEmergency) >= 0,
+			})
+		}
+		s.Triage = t
+	}
+	if r.Specialist != nil {
+		s.Specialist = r.Specialist.RecommendedSpecialist.Name
+		s.Channel = label(string(r.Specialist.RecommendedChannel))
+	}
+	return s, nil
+}
+
+// Render writes the summary of a finished interview in the given format
+func (b Builder) Render(w io.Writer, f Format, r *infermedica.InterviewResult) error {
+	if err := f.IsValid(); err != nil {
+		return err
+	}
+	s, err := b.Summarize(r)
+	if err != nil {
+		return err
+	}
+	text, ok := b.Templates[f]
+	if !ok {
+		text = defaultTemplates[f]
+	}
+	if f == FormatHTML {
+		t, err := htmltemplate.New(string(f)).Parse(text)
+		if err != nil {
+			return err
+		}
+		return t.Execute(w, s)
+	}
+	t, err := texttemplate.New(string(f)).Funcs(texttemplate.FuncMap{"markdown": markdownEscape}).Parse(text)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, s)
+}
+
+// String renders the summary of a finished interview to a string
+func (b Builder) String(f Format, r *infermedica.InterviewResult) (string, error) {
+	var sb strings.Builder
+	if err := b.Render(&sb, f, r); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// label returns a readable form of an API value, e.g. "consultation_24" becomes "Consultation 24"
+func label(s string) string {
+	s = strings.ReplaceAll(s, "_", " ")
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func age(a infermedica.Age) string {
+	if a.Unit == infermedica.AgeUnitMonth {
+		return plural(a.Months(), "month")
+	}
+	return plural(a.Years(), "year")
+}
+
+func duration(d infermedica.Duration) string {
+	return plural(d.Value, string(d.Unit))
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return strconv.Itoa(n) + " " + unit + "s"
+}
+
+func bar(p float64, width int) string {
+	filled := int(p*float64(width) + 0.5)
+	if filled < 0 {
+		filled = 0
+	}
+	if filled > width {
+		filled = width
+	}
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "|", `\|`, "<", `&lt;`)
+
+// markdownEscape escapes the text so it is not interpreted as Markdown
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,135 @@
+package report
+
+// defaultTemplates are used for the formats without a template in Builder.Templates
+var defaultTemplates = map[Format]string{
+	FormatMarkdown: markdownTemplate,
+	FormatHTML:     htmlTemplate,
+	FormatText:     textTemplate,
+}
+
+const markdownTemplate = `# Clinical summary
+{{if .InterviewID}}
+Interview {{.InterviewID}}{{if .EvaluatedAt}}, evaluated at {{.EvaluatedAt}}{{end}}
+{{end}}
+**Patient:** {{.Sex}}, {{.Age}}
+{{with .Triage}}
+## Triage: {{.Label}}{{if .IsEmergency}} ⚠{{end}}
+{{range .Serious}}
+- {{markdown .Name}} ({{.Seriousness}})
+{{- end}}
+{{end}}{{if .Specialist}}
+**Recommended specialist:** {{markdown .Specialist}}{{if .Channel}} ({{.Channel}}){{end}}
+{{end}}{{if .Present}}
+## Present symptoms
+{{range .Present}}
+- {{markdown .Name}}{{if .Duration}}, for {{.Duration}}{{end}}
+{{- end}}
+{{end}}{{if .Absent}}
+## Absent symptoms
+{{range .Absent}}
+- {{markdown .Name}}
+{{- end}}
+{{end}}{{if .Unknown}}
+## Unknown
+{{range .Unknown}}
+- {{markdown .Name}}
+{{- end}}
+{{end}}{{if .RiskFactors}}
+## Risk factors
+{{range .RiskFactors}}
+- {{markdown .Name}}
+{{- end}}
+{{end}}{{if .Conditions}}
+## Conditions
+{{range .Conditions}}
+- ` + "`{{.Bar}}`" + ` {{.Percent}} **{{markdown .CommonName}}**{{if .Icd10Code}} ({{.Icd10Code}}){{end}}
+{{- if .Hint}}
+  _{{markdown .Hint}}_
+{{- end}}
+{{- end}}
+{{end}}`
+
+const textTemplate = `CLINICAL SUMMARY
+{{if .InterviewID}}Interview {{.InterviewID}}{{if .EvaluatedAt}}, evaluated at {{.EvaluatedAt}}{{end}}
+{{end}}
+Patient: {{.Sex}}, {{.Age}}
+{{with .Triage}}
+Triage: {{.Label}}{{if .IsEmergency}} (EMERGENCY){{end}}
+{{- range .Serious}}
+  ! {{.Name}} ({{.Seriousness}})
+{{- end}}
+{{end}}{{if .Specialist}}Recommended specialist: {{.Specialist}}{{if .Channel}} ({{.Channel}}){{end}}
+{{end}}{{if .Present}}
+Present symptoms:
+{{- range .Present}}
+  + {{.Name}}{{if .Duration}}, for {{.Duration}}{{end}}
+{{- end}}
+{{end}}{{if .Absent}}
+Absent symptoms:
+{{- range .Absent}}
+  - {{.Name}}
+{{- end}}
+{{end}}{{if .Unknown}}
+Unknown:
+{{- range .Unknown}}
+  ? {{.Name}}
+{{- end}}
+{{end}}{{if .RiskFactors}}
+Risk factors:
+{{- range .RiskFactors}}
+  * {{.Name}}
+{{- end}}
+{{end}}{{if .Conditions}}
+Conditions:
+{{- range .Conditions}}
+  {{.Bar}} {{printf "%4s" .Percent}} {{.CommonName}}{{if .Icd10Code}} ({{.Icd10Code}}){{end}}
+{{- if .Hint}}
+       {{.Hint}}
+{{- end}}
+{{- end}}
+{{end}}`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Clinical summary</title>
+<style>
+body { font-family: sans-serif; }
+.emergency { color: #b00020; }
+.bar { display: inline-block; width: 10em; height: 0.8em; background: #eee; }
+.bar span { display: block; height: 100%; background: #3367d6; }
+</style>
+</head>
+<body>
+<h1>Clinical summary</h1>
+{{if .InterviewID}}<p>Interview {{.InterviewID}}{{if .EvaluatedAt}}, evaluated at {{.EvaluatedAt}}{{end}}</p>{{end}}
+<p><strong>Patient:</strong> {{.Sex}}, {{.Age}}</p>
+{{with .Triage}}<h2{{if .IsEmergency}} class="emergency"{{end}}>Triage: {{.Label}}</h2>
+{{if .Serious}}<ul>
+{{range .Serious}}<li{{if .IsEmergency}} class="emergency"{{end}}>{{.Name}} ({{.Seriousness}})</li>
+{{end}}</ul>
+{{end}}{{end}}{{if .Specialist}}<p><strong>Recommended specialist:</strong> {{.Specialist}}{{if .Channel}} ({{.Channel}}){{end}}</p>
+{{end}}{{if .Present}}<h2>Present symptoms</h2>
+<ul>
+{{range .Present}}<li>{{.Name}}{{if .Duration}}, for {{.Duration}}{{end}}</li>
+{{end}}</ul>
+{{end}}{{if .Absent}}<h2>Absent symptoms</h2>
+<ul>
+{{range .Absent}}<li>{{.Name}}</li>
+{{end}}</ul>
+{{end}}{{if .Unknown}}<h2>Unknown</h2>
+<ul>
+{{range .Unknown}}<li>{{.Name}}</li>
+{{end}}</ul>
+{{end}}{{if .RiskFactors}}<h2>Risk factors</h2>
+<ul>
+{{range .RiskFactors}}<li>{{.Name}}</li>
+{{end}}</ul>
+{{end}}{{if .Conditions}}<h2>Conditions</h2>
+<table>
+{{range .Conditions}}<tr><td><span class="bar"><span style="width: {{.Percent}}"></span></span></td><td>{{.Percent}}</td><td><strong>{{.CommonName}}</strong>{{if .Icd10Code}} ({{.Icd10Code}}){{end}}{{if .Hint}}<br><em>{{.Hint}}</em>{{end}}</td></tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`
@@ -0,0 +1,40 @@
+package infermedica
+
+// InterviewResult is a finished interview: the patient, the collected evidence and the results
+// of the API, it is the input of the export and report packages
+type InterviewResult struct {
+	ID          string // Interview-Id
+	Sex         Sex
+	Age         Age
+	EvaluatedAt string
+	Evidence    []Evidence
+	Diagnosis   *DiagnosisRes           // Last diagnosis of the interview
+	Triage      *TriageRes              // Optional
+	Specialist  *RecommendSpecialistRes // Optional
+	Catalog     *Catalog                // Optional, used to name the evidence
+}
+
+// Name returns the common name of an observation or condition, found in the results or the catalog,
+// or the ID when it is unknown
+func (r *InterviewResult) Name(id string) string {
+	if r.Diagnosis != nil {
+		for _, c := range r.Diagnosis.Conditions {
+			if c.ID == id {
+				return c.CommonName
+			}
+		}
+	}
+	if r.Triage != nil {
+		for _, s := range r.Triage.Serious {
+			if s.ID == id {
+				return s.CommonName
+			}
+		}
+	}
+	if r.Catalog != nil {
+		if _, commonName, ok := r.Catalog.Names(id); ok {
+			return commonName
+		}
+	}
+	return id
+}
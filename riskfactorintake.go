@@ -0,0 +1,119 @@
+package infermedica
+
+import "fmt"
+
+// RiskFactorIntake is the stage before the interview that asks the demographic and evidence-based risk factors
+type RiskFactorIntake struct {
+	RiskFactors []RiskFactorRes
+}
+
+// NewRiskFactorIntake requests the demographic and evidence-based risk factors for the patient
+// and loads their questions with a single RiskFactors request
+func (a *App) NewRiskFactorIntake(sex Sex, age Age, evidences []Evidence, opts ...RequestOption) (*RiskFactorIntake, error) {
+	var suggested []SuggestRes
+	seen := map[string]bool{}
+	for _, method := range []SuggestMethod{SuggestMethoddemoGraphicRiskFactors, SuggestMethodEvidenceBasedRiskFactors} {
+		suggestions, err := a.Suggest(SuggestReq{
+			Sex:           sex,
+			Age:           age,
+			Evidences:     evidences,
+			SuggestMethod: method,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range *suggestions {
+			if seen[s.ID] {
+				continue
+			}
+			seen[s.ID] = true
+			suggested = append(suggested, s)
+		}
+	}
+
+	var r RiskFactorIntake
+	if len(suggested) == 0 {
+		return &r, nil
+	}
+	riskFactors, err := a.RiskFactors(age, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]RiskFactorRes, len(*riskFactors))
+	for _, rf := range *riskFactors {
+		byID[rf.ID] = rf
+	}
+	for _, s := range suggested {
+		rf, ok := byID[s.ID]
+		if !ok {
+			// Not in the knowledge base for this age, the question falls back to the common name
+			rf = RiskFactorRes{ID: s.ID, Name: s.Name, CommonName: s.CommonName}
+		}
+		r.RiskFactors = append(r.RiskFactors, rf)
+	}
+	return &r, nil
+}
+
+// Questions returns one single question per risk factor, in the third person when asking on behalf of someone else
+func (r *RiskFactorIntake) Questions(thirdPerson bool) []Question {
+	questions := make([]Question, 0, len(r.RiskFactors))
+	for _, rf := range r.RiskFactors {
+		text := rf.Question
+		if thirdPerson && rf.QuestionThirdPerson != "" {
+			text = rf.QuestionThirdPerson
+		}
+		if text == "" {
+			text = rf.CommonName
+		}
+		questions = append(questions, Question{
+			Type: QuestionTypeSingle,
+			Text: text,
+			Items: []QuestionItem{{
+				ID:   rf.ID,
+				Name: rf.CommonName,
+				Choices: []QuestionItemChoice{
+					{ID: EvidenceChoiceIDPresent, Label: "Yes"},
+					{ID: EvidenceChoiceIDAbsent, Label: "No"},
+					{ID: EvidenceChoiceIDUnknown, Label: "Don't know"},
+				},
+			}},
+		})
+	}
+	return questions
+}
+
+// Answer records the answers by risk factor ID with the suggest source, merged into evidences
+func (r *RiskFactorIntake) Answer(evidences []Evidence, answers map[string]EvidenceChoiceID) ([]Evidence, error) {
+	recorded := make([]Evidence, 0, len(answers))
+	for _, rf := range r.RiskFactors {
+		choice, ok := answers[rf.ID]
+		if !ok {
+			continue
+		}
+		if err := choice.IsValid(); err != nil {
+			return nil, err
+		}
+		recorded = append(recorded, Evidence{
+			ID:       rf.ID,
+			ChoiceID: choice,
+			Source:   EvidenceSourceSuggest,
+		})
+	}
+	if len(recorded) != len(answers) {
+		for id := range answers {
+			if !r.has(id) {
+				return nil, fmt.Errorf("infermedica: unexpected risk factor: %q", id)
+			}
+		}
+	}
+	return MergeEvidence(evidences, recorded, ConflictRulePreferLast), nil
+}
+
+func (r *RiskFactorIntake) has(id string) bool {
+	for _, rf := range r.RiskFactors {
+		if rf.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"net/http"
 	"strconv"
 	"time"
@@ -18,10 +17,11 @@ type RiskFactorRes struct {
 	Extras              any       `json:"extras"`
 	ImageURL            string    `json:"image_url"`
 	ImageSource         string    `json:"image_source"`
+	Unknown             Unknown   `json:"-"` // Only when WithUnknownFields is used
 }
 
-func (a *App) RiskFactors(age Age, enableTriage3 bool) (*[]RiskFactorRes, error) {
-	req, err := a.prepareRequest("GET", "risk_factors?age.value="+strconv.Itoa(age.Value)+"&age.unit"+string(age.Unit)+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil)
+func (a *App) RiskFactors(age Age, enableTriage3 bool, opts ...RequestOption) (*[]RiskFactorRes, error) {
+	req, err := a.prepareRequest("GET", "risk_factors?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -38,15 +38,15 @@ func (a *App) RiskFactors(age Age, enableTriage3 bool) (*[]RiskFactorRes, error)
 		return nil, err
 	}
 	var r []RiskFactorRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-func (a *App) RiskFactorByID(id string) (*RiskFactorRes, error) {
-	req, err := a.prepareRequest("GET", "risk_factors/"+id, nil)
+func (a *App) RiskFactorByID(id string, opts ...RequestOption) (*RiskFactorRes, error) {
+	req, err := a.prepareRequest("GET", "risk_factors/"+id, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +65,7 @@ func (a *App) RiskFactorByID(id string) (*RiskFactorRes, error) {
 		return nil, err
 	}
 	var r RiskFactorRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -20,8 +19,9 @@ type SearchReq struct {
 }
 
 type SearchRes struct {
-	ID    string `json:"id"`
-	Label string `json:"label"`
+	ID      string  `json:"id"`
+	Label   string  `json:"label"`
+	Unknown Unknown `json:"-"` // Only when WithUnknownFields is used
 }
 
 type SearchType string
@@ -55,7 +55,7 @@ func SearchTypeFromString(x string) (SearchType, error) {
 }
 
 // Search returns a list of observations matching the given phrase.
-func (a *App) Search(sq SearchReq) (*[]SearchRes, error) {
+func (a *App) Search(sq SearchReq, opts ...RequestOption) (*[]SearchRes, error) {
 	if sq.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: Unexpected value for Sex")
 	}
@@ -66,8 +66,8 @@ func (a *App) Search(sq SearchReq) (*[]SearchRes, error) {
 		return nil, fmt.Errorf("infermedica: MaxResult can not be zero or less")
 	}
 	url := "search?phrase=" + url.QueryEscape(sq.Phrase) + "&sex=" + string(sq.Sex) +
-		"&max_results=" + strconv.Itoa(sq.MaxResults) + "&types=" + string(sq.Types) + "&age.value=" + strconv.Itoa(sq.Age.Value) + "&age.unit=" + string(sq.Age.Unit)
-	req, err := a.prepareRequest("GET", url, nil)
+		"&max_results=" + strconv.Itoa(sq.MaxResults) + "&types=" + string(sq.Types) + "&" + sq.Age.query()
+	req, err := a.prepareRequest("GET", url, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +86,7 @@ func (a *App) Search(sq SearchReq) (*[]SearchRes, error) {
 		return nil, err
 	}
 	var r []SearchRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// Quota limits the number of requests of a client in a time window
+type Quota struct {
+	Requests int           // Requests allowed per window, no limit when zero
+	Window   time.Duration // One minute when zero
+}
+
+// quotaCounter counts the requests of every client in fixed windows
+type quotaCounter struct {
+	quota Quota
+
+	mu      sync.Mutex
+	windows map[string]quotaWindow
+}
+
+type quotaWindow struct {
+	start time.Time
+	count int
+}
+
+// allow records a request of the client and reports whether it is within the quota
+func (q *quotaCounter) allow(clientID string, now time.Time) bool {
+	if q.quota.Requests <= 0 {
+		return true
+	}
+	window := q.quota.Window
+	if window <= 0 {
+		window = time.Minute
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.windows == nil {
+		q.windows = map[string]quotaWindow{}
+	}
+	w := q.windows[clientID]
+	if now.Sub(w.start) >= window {
+		w = quotaWindow{start: now}
+	}
+	if w.count >= q.quota.Requests {
+		return false
+	}
+	w.count++
+	q.windows[clientID] = w
+	return true
+}
@@ -0,0 +1,336 @@
+// Package server is an HTTP gateway that exposes a simplified interview API backed by an App,
+// so that App-Id and App-Key stay on the server and mobile apps only hold a client token.
+//
+// Endpoints:
+//
+//	POST /interviews                start an interview from demographics and free text or evidence
+//	POST /interviews/{id}/answers   answer the last question and get the next one
+//	GET  /interviews/{id}/result    get the conditions, triage and recommended specialist
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// Server is an http.Handler for the gateway API
+type Server struct {
+	app     *infermedica.App
+	store   Store
+	clients map[string]string // Client token to client ID
+	quota   quotaCounter
+	locks   sessionLocks
+}
+
+// Config configures a Server
+type Config struct {
+	Clients map[string]string // Client token to client ID, sent as "Authorization: Bearer <token>"
+	Store   Store             // A MemoryStore when nil
+	Quota   Quota             // Requests allowed per client
+}
+
+// New returns a Server that calls the API with app
+func New(app *infermedica.App, c Config) *Server {
+	store := c.Store
+	if store == nil {
+		store = &MemoryStore{}
+	}
+	return &Server{
+		app:     app,
+		store:   store,
+		clients: c.Clients,
+		quota:   quotaCounter{quota: c.Quota},
+	}
+}
+
+// StartReq is the body of POST /interviews
+type StartReq struct {
+	Sex      infermedica.Sex        `json:"sex"`
+	Age      infermedica.Age        `json:"age"`
+	Text     string                 `json:"text,omitempty"`     // Free text parsed into initial evidence
+	Evidence []infermedica.Evidence `json:"evidence,omitempty"` // Initial evidence
+}
+
+// AnswerReq is the body of POST /interviews/{id}/answers
+type AnswerReq struct {
+	Answers  []infermedica.Evidence `json:"answers,omitempty"`
+	Duration string                 `json:"duration,omitempty"` // Answer to a duration question, e.g. "3 days"
+}
+
+// InterviewRes is the response of POST /interviews and POST /interviews/{id}/answers
+type InterviewRes struct {
+	ID         string                `json:"id"`
+	Question   *infermedica.Question `json:"question,omitempty"`
+	ShouldStop bool                  `json:"should_stop"`
+}
+
+// ResultRes is the response of GET /interviews/{id}/result
+type ResultRes struct {
+	ID                    string                             `json:"id"`
+	Conditions            []infermedica.Conditions           `json:"conditions"`
+	Triage                infermedica.TriageRes              `json:"triage"`
+	RecommendedSpecialist infermedica.RecommendSpecialistRes `json:"recommended_specialist"`
+}
+
+type errorRes struct {
+	Message string `json:"message"`
+}
+
+// httpError is an error with the status code it is reported with
+type httpError struct {
+	status  int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := s.authenticate(r)
+	if !ok {
+		writeError(w, &httpError{http.StatusUnauthorized, "invalid client token"})
+		return
+	}
+	if !s.quota.allow(clientID, time.Now()) {
+		writeError(w, &httpError{http.StatusTooManyRequests, "quota exceeded"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var v interface{}
+	var err error
+	switch {
+	case len(parts) == 1 && parts[0] == "interviews" && r.Method == http.MethodPost:
+		v, err = s.start(r, clientID)
+	case len(parts) == 3 && parts[0] == "interviews" && parts[2] == "answers" && r.Method == http.MethodPost:
+		v, err = s.answer(r, clientID, parts[1])
+	case len(parts) == 3 && parts[0] == "interviews" && parts[2] == "result" && r.Method == http.MethodGet:
+		v, err = s.result(clientID, parts[1])
+	default:
+		err = &httpError{http.StatusNotFound, "not found"}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) authenticate(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return "", false
+	}
+	clientID, ok := s.clients[token]
+	return clientID, ok
+}
+
+func (s *Server) start(r *http.Request, clientID string) (*InterviewRes, error) {
+	var req StartReq
+	err := decodeBody(r, &req)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Sex.IsValid(); err != nil {
+		return nil, &httpError{http.StatusBadRequest, err.Error()}
+	}
+	if err := req.Age.IsValid(); err != nil {
+		return nil, &httpError{http.StatusBadRequest, err.Error()}
+	}
+
+	now := time.Now()
+	session := Session{
+		ID:        newID(),
+		ClientID:  clientID,
+		Sex:       req.Sex,
+		Age:       req.Age,
+		Evidence:  infermedica.MergeEvidence(nil, req.Evidence, infermedica.ConflictRulePreferLast),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if req.Text != "" {
+		parsed, err := s.app.Parse(infermedica.ParseReq{
+			Text:            req.Text,
+			Age:             req.Age,
+			Sex:             req.Sex,
+			CorrectSpelling: true,
+		}, infermedica.WithInterviewID(session.ID))
+		if err != nil {
+			return nil, err
+		}
+		session.Evidence = parsed.ToEvidence(infermedica.ParseEvidenceOptions{Existing: session.Evidence})
+	}
+	if len(session.Evidence) == 0 {
+		return nil, &httpError{http.StatusUnprocessableEntity, "no evidence: give evidence or a text with symptoms"}
+	}
+	err = s.diagnose(&session)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.Create(&session)
+	if err != nil {
+		return nil, err
+	}
+	return &InterviewRes{ID: session.ID, Question: session.Question, ShouldStop: session.ShouldStop}, nil
+}
+
+func (s *Server) answer(r *http.Request, clientID, id string) (*InterviewRes, error) {
+	// Answers to the same interview are applied one at a time, the store rejects the update
+	// when another server changed the session in the meantime
+	unlock := s.locks.lock(id)
+	defer unlock()
+	session, err := s.session(clientID, id)
+	if err != nil {
+		return nil, err
+	}
+	read := session.UpdatedAt
+	var req AnswerReq
+	err = decodeBody(r, &req)
+	if err != nil {
+		return nil, err
+	}
+	if session.Question == nil {
+		return nil, &httpError{http.StatusConflict, "the interview has no pending question"}
+	}
+	if req.Duration != "" {
+		d, err := infermedica.ParseDuration(req.Duration)
+		if err != nil {
+			return nil, &httpError{http.StatusBadRequest, err.Error()}
+		}
+		err = infermedica.AttachDuration(session.Evidence, *session.Question, d)
+		if err != nil {
+			return nil, &httpError{http.StatusBadRequest, err.Error()}
+		}
+	}
+	for _, a := range req.Answers {
+		if !hasItem(session.Question, a.ID) {
+			return nil, &httpError{http.StatusBadRequest, fmt.Sprintf("%q is not an item of the question", a.ID)}
+		}
+		if err := a.ChoiceID.IsValid(); err != nil {
+			return nil, &httpError{http.StatusBadRequest, err.Error()}
+		}
+	}
+	session.Evidence = infermedica.MergeEvidence(session.Evidence, req.Answers, infermedica.ConflictRulePreferLast)
+	err = s.diagnose(session)
+	if err != nil {
+		return nil, err
+	}
+	session.UpdatedAt = time.Now()
+	err = s.store.Update(session, read)
+	if errors.Is(err, ErrConflict) {
+		return nil, &httpError{http.StatusConflict, err.Error()}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &InterviewRes{ID: session.ID, Question: session.Question, ShouldStop: session.ShouldStop}, nil
+}
+
+func (s *Server) result(clientID, id string) (*ResultRes, error) {
+	session, err := s.session(clientID, id)
+	if err != nil {
+		return nil, err
+	}
+	triage, err := s.app.Triage(infermedica.TriageReq{
+		Sex:       session.Sex,
+		Age:       session.Age,
+		Evidences: session.Evidence,
+	}, infermedica.WithInterviewID(session.ID))
+	if err != nil {
+		return nil, err
+	}
+	specialist, err := s.app.RecommendSpecialist(infermedica.RecommendSpecialistReq{
+		Sex:       session.Sex,
+		Age:       session.Age,
+		Evidences: session.Evidence,
+	}, infermedica.WithInterviewID(session.ID))
+	if err != nil {
+		return nil, err
+	}
+	return &ResultRes{
+		ID:                    session.ID,
+		Conditions:            session.Conditions,
+		Triage:                *triage,
+		RecommendedSpecialist: *specialist,
+	}, nil
+}
+
+// diagnose asks the next question of the session
+func (s *Server) diagnose(session *Session) error {
+	diagnosis, err := s.app.Diagnosis(infermedica.DiagnosisReq{
+		Sex:       session.Sex,
+		Age:       session.Age,
+		Evidences: session.Evidence,
+	}, infermedica.WithInterviewID(session.ID))
+	if err != nil {
+		return err
+	}
+	session.Conditions = diagnosis.Conditions
+	session.ShouldStop = diagnosis.ShouldStop
+	session.Question = nil
+	if !diagnosis.ShouldStop && len(diagnosis.Question.Items) > 0 {
+		session.Question = &diagnosis.Question
+	}
+	return nil
+}
+
+// session returns a session of the client, sessions of other clients are not found
+func (s *Server) session(clientID, id string) (*Session, error) {
+	session, err := s.store.Get(id)
+	if errors.Is(err, ErrNotFound) || (err == nil && session.ClientID != clientID) {
+		return nil, &httpError{http.StatusNotFound, ErrNotFound.Error()}
+	}
+	return session, err
+}
+
+func hasItem(q *infermedica.Question, id string) bool {
+	for _, item := range q.Items {
+		if item.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	d := json.NewDecoder(http.MaxBytesReader(nil, r.Body, 1<<20))
+	d.DisallowUnknownFields()
+	err := d.Decode(v)
+	if err != nil {
+		return &httpError{http.StatusBadRequest, "invalid body: " + err.Error()}
+	}
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	message := "infermedica request failed"
+	var he *httpError
+	if errors.As(err, &he) {
+		status = he.status
+		message = he.message
+	} else {
+		// API errors can contain details that clients must not see
+		log.Printf("server: %v", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorRes{Message: message})
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// ErrNotFound is returned by a Store when the session does not exist or expired
+var ErrNotFound = errors.New("server: interview not found")
+
+// ErrConflict is returned by Store.Update when the session was updated since it was read
+var ErrConflict = errors.New("server: interview was updated concurrently")
+
+// Session is the server side state of an interview
+type Session struct {
+	ID         string
+	ClientID   string
+	Sex        infermedica.Sex
+	Age        infermedica.Age
+	Evidence   []infermedica.Evidence
+	Question   *infermedica.Question // Last question asked, nil when the interview should stop
+	Conditions []infermedica.Conditions
+	ShouldStop bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Store keeps the sessions, implementations must be safe for concurrent use
+type Store interface {
+	Create(s *Session) error
+	Get(id string) (*Session, error)
+	// Update replaces the session only if its stored UpdatedAt still equals updatedAt, the value read
+	// with Get, and returns ErrConflict otherwise, so concurrent answers never overwrite each other
+	Update(s *Session, updatedAt time.Time) error
+}
+
+// MemoryStore keeps the sessions in memory, sessions not updated for TTL are removed
+type MemoryStore struct {
+	TTL time.Duration // 24 hours when zero
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+func (m *MemoryStore) ttl() time.Duration {
+	if m.TTL <= 0 {
+		return 24 * time.Hour
+	}
+	return m.TTL
+}
+
+func (m *MemoryStore) Create(s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sessions == nil {
+		m.sessions = map[string]*Session{}
+	}
+	m.expire()
+	c := *s
+	m.sessions[s.ID] = &c
+	return nil
+}
+
+func (m *MemoryStore) Get(id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[id]
+	if !ok || time.Since(s.UpdatedAt) > m.ttl() {
+		return nil, ErrNotFound
+	}
+	c := *s
+	c.Evidence = append([]infermedica.Evidence(nil), s.Evidence...)
+	return &c, nil
+}
+
+func (m *MemoryStore) Update(s *Session, updatedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.sessions[s.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	if !stored.UpdatedAt.Equal(updatedAt) {
+		return ErrConflict
+	}
+	c := *s
+	m.sessions[s.ID] = &c
+	return nil
+}
+
+// expire removes the expired sessions, m.mu must be held
+func (m *MemoryStore) expire() {
+	for id, s := range m.sessions {
+		if time.Since(s.UpdatedAt) > m.ttl() {
+			delete(m.sessions, id)
+		}
+	}
+}
+
+// sessionLocks serializes the requests that modify the same session within one server
+type sessionLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sessionLock
+}
+
+type sessionLock struct {
+	sync.Mutex
+	users int
+}
+
+// lock locks the session and returns the function that unlocks it
+func (l *sessionLocks) lock(id string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*sessionLock{}
+	}
+	sl, ok := l.locks[id]
+	if !ok {
+		sl = &sessionLock{}
+		l.locks[id] = sl
+	}
+	sl.users++
+	l.mu.Unlock()
+
+	sl.Lock()
+	return func() {
+		sl.Unlock()
+		l.mu.Lock()
+		sl.users--
+		if sl.users == 0 {
+			delete(l.locks, id)
+		}
+		l.mu.Unlock()
+	}
+}
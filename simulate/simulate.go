@@ -0,0 +1,218 @@
+// Package simulate runs Diagnosis interviews with a simulated patient that answers from a ground truth,
+// to measure interview length, stopping behavior and outcomes of different interview settings.
+package simulate
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// Patient answers the interview questions from a ground truth evidence set
+type Patient struct {
+	Sex   infermedica.Sex
+	Age   infermedica.Age
+	Truth []infermedica.Evidence // Every observation the patient knows about
+
+	Initial         []infermedica.Evidence       // Evidence given before the first question, when nil the present items of Truth with an initial source, or every present item of Truth (sent as initial) when none has one
+	Default         infermedica.EvidenceChoiceID // Answer to questions about observations not in Truth, absent when empty
+	DefaultDuration infermedica.Duration         // Answer to duration questions when Truth has no duration, 1 day when zero
+	Noise           float64                      // Probability of answering the opposite of the truth (present and absent only)
+	Rand            *rand.Rand                   // Source of the noise, required when Noise is not zero
+}
+
+func (p *Patient) truth(id string) (infermedica.Evidence, bool) {
+	for _, e := range p.Truth {
+		if e.ID == id {
+			return e, true
+		}
+	}
+	return infermedica.Evidence{}, false
+}
+
+// choice returns the answer of the patient about an observation, with noise
+func (p *Patient) choice(id string) infermedica.EvidenceChoiceID {
+	choice := p.Default
+	if choice == "" {
+		choice = infermedica.EvidenceChoiceIDAbsent
+	}
+	if e, ok := p.truth(id); ok {
+		choice = e.ChoiceID
+	}
+	if p.Noise > 0 && p.Rand.Float64() < p.Noise {
+		switch choice {
+		case infermedica.EvidenceChoiceIDPresent:
+			choice = infermedica.EvidenceChoiceIDAbsent
+		case infermedica.EvidenceChoiceIDAbsent:
+			choice = infermedica.EvidenceChoiceIDPresent
+		}
+	}
+	return choice
+}
+
+func (p *Patient) initial() []infermedica.Evidence {
+	if p.Initial != nil {
+		return p.Initial
+	}
+	var tagged, present []infermedica.Evidence
+	for _, e := range p.Truth {
+		if e.ChoiceID != infermedica.EvidenceChoiceIDPresent {
+			continue
+		}
+		if e.Source == infermedica.EvidenceSourceInitial {
+			tagged = append(tagged, e)
+		}
+		e.Source = infermedica.EvidenceSourceInitial
+		present = append(present, e)
+	}
+	if len(tagged) > 0 {
+		return tagged
+	}
+	return present
+}
+
+// Answer answers a question and returns the evidence merged into evidences
+func (p *Patient) Answer(q infermedica.Question, evidences []infermedica.Evidence) ([]infermedica.Evidence, error) {
+	var answers []infermedica.Evidence
+	switch q.Type {
+	case infermedica.QuestionTypeSingle, infermedica.QuestionTypeGroupMultiple:
+		for _, item := range q.Items {
+			answers = append(answers, infermedica.Evidence{ID: item.ID, ChoiceID: p.choice(item.ID)})
+		}
+	case infermedica.QuestionTypeGroupSingle:
+		// Only one item can be present, the first present item is chosen
+		for _, item := range q.Items {
+			if p.choice(item.ID) == infermedica.EvidenceChoiceIDPresent {
+				answers = append(answers, infermedica.Evidence{ID: item.ID, ChoiceID: infermedica.EvidenceChoiceIDPresent})
+				break
+			}
+		}
+		if len(answers) == 0 {
+			for _, item := range q.Items {
+				answers = append(answers, infermedica.Evidence{ID: item.ID, ChoiceID: infermedica.EvidenceChoiceIDUnknown})
+			}
+		}
+	case infermedica.QuestionTypeDuration:
+		d := p.DefaultDuration
+		if e, ok := p.truth(q.EvidenceID); ok && e.Duration != nil {
+			d = *e.Duration
+		}
+		if d.Value == 0 {
+			d = infermedica.Duration{Value: 1, Unit: infermedica.DurationUnitDay}
+		}
+		evidences = append([]infermedica.Evidence(nil), evidences...)
+		err := infermedica.AttachDuration(evidences, q, d)
+		if err != nil {
+			return nil, err
+		}
+		return evidences, nil
+	default:
+		return nil, errors.New("simulate: unexpected question type: " + string(q.Type))
+	}
+	return infermedica.MergeEvidence(evidences, answers, infermedica.ConflictRulePreferLast), nil
+}
+
+// Interview configures the simulated interviews
+type Interview struct {
+	App          *infermedica.App
+	Extras       *infermedica.DiagnosisReqExtras // Interview mode and flags sent with every Diagnosis
+	MaxQuestions int                             // Safety limit of questions, 50 when zero
+	Options      []infermedica.RequestOption
+}
+
+// Outcome is the result of a simulated interview
+type Outcome struct {
+	Questions  int                      // Number of questions asked
+	ShouldStop bool                     // False when MaxQuestions was reached before the API asked to stop
+	Conditions []infermedica.Conditions // Final ranking
+	Triage     *infermedica.TriageRes
+	Evidence   []infermedica.Evidence // Evidence collected during the interview
+}
+
+// Run interviews the patient until Diagnosis returns ShouldStop, then requests the triage
+func (in *Interview) Run(p *Patient) (*Outcome, error) {
+	if p.Noise > 0 && p.Rand == nil {
+		return nil, errors.New("simulate: Rand is required when Noise is not zero")
+	}
+	maxQuestions := in.MaxQuestions
+	if maxQuestions <= 0 {
+		maxQuestions = 50
+	}
+	o := Outcome{Evidence: p.initial()}
+	if len(o.Evidence) == 0 {
+		return nil, errors.New("simulate: the patient has no initial evidence")
+	}
+	for {
+		diagnosis, err := in.App.Diagnosis(infermedica.DiagnosisReq{
+			Sex:       p.Sex,
+			Age:       p.Age,
+			Evidences: o.Evidence,
+			Extras:    in.Extras,
+		}, in.Options...)
+		if err != nil {
+			return nil, err
+		}
+		o.Conditions = diagnosis.Conditions
+		o.ShouldStop = diagnosis.ShouldStop
+		if diagnosis.ShouldStop || len(diagnosis.Question.Items) == 0 || o.Questions == maxQuestions {
+			break
+		}
+		o.Evidence, err = p.Answer(diagnosis.Question, o.Evidence)
+		if err != nil {
+			return nil, err
+		}
+		o.Questions++
+	}
+
+	var triageExtras *infermedica.TriageReqExtras
+	if in.Extras != nil {
+		triageExtras = &infermedica.TriageReqExtras{
+			EnableTriage3:         in.Extras.EnableTriage3,
+			EnableSymptomDuration: in.Extras.EnableSymptomDuration,
+		}
+	}
+	triage, err := in.App.Triage(infermedica.TriageReq{
+		Sex:       p.Sex,
+		Age:       p.Age,
+		Evidences: o.Evidence,
+		Extras:    triageExtras,
+	}, in.Options...)
+	if err != nil {
+		return nil, err
+	}
+	o.Triage = triage
+	return &o, nil
+}
+
+// Stats aggregates the outcomes of several interviews run with the same settings
+type Stats struct {
+	Interviews    int
+	MeanQuestions float64
+	MaxQuestions  int
+	Stopped       int                             // Interviews that ended because the API asked to stop
+	TriageLevels  map[infermedica.TriageLevel]int // Number of interviews by triage level
+}
+
+// Summarize aggregates outcomes, so interview modes and extras can be compared
+func Summarize(outcomes []*Outcome) Stats {
+	s := Stats{TriageLevels: map[infermedica.TriageLevel]int{}}
+	total := 0
+	for _, o := range outcomes {
+		s.Interviews++
+		total += o.Questions
+		if o.Questions > s.MaxQuestions {
+			s.MaxQuestions = o.Questions
+		}
+		if o.ShouldStop {
+			s.Stopped++
+		}
+		if o.Triage != nil {
+			s.TriageLevels[o.Triage.TriageLevel]++
+		}
+	}
+	if s.Interviews > 0 {
+		s.MeanQuestions = float64(total) / float64(s.Interviews)
+	}
+	return s
+}
@@ -1,9 +1,10 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -11,13 +12,16 @@ import (
 type SuggestReq struct {
 	Sex           Sex            `json:"sex"`
 	Age           Age            `json:"age"`
+	EvaluatedAt   string         `json:"evaluated_at,omitempty"`
 	Evidences     []Evidence     `json:"evidence"`
-	SuggestMethod SuggestMethod  `json:"suggest_method"`
-	Extras        *SuggestExtras `json:"extras"`
+	SuggestMethod SuggestMethod  `json:"suggest_method,omitempty"` // SuggestMethodSymptoms when empty
+	MaxResults    int            `json:"max_results,omitempty"`    // The API default is used when zero
+	Extras        *SuggestExtras `json:"extras,omitempty"`
 }
 
-type SuggestExtras []struct {
-	EnableExplanations bool `json:"enable_explanations,omitempty"` // This functionality helps users to better understand the purpose of a question. It expands the question with two additional fields: explication and instruction
+type SuggestExtras struct {
+	EnableExplanations    bool `json:"enable_explanations,omitempty"`     // This functionality helps users to better understand the purpose of a question. It expands the question with two additional fields: explication and instruction
+	EnableSymptomDuration bool `json:"enable_symptom_duration,omitempty"` // This flag enables questions of the type duration which contain a new field EvidenceID
 }
 
 // SuggestRes is a response struct for suggest
@@ -27,6 +31,7 @@ type SuggestRes struct {
 	CommonName  string   `json:"common_name"`
 	Explication string   `json:"explication"` // Enabled only when EnableExplanations is true
 	Instruction []string `json:"instruction"` // Enabled only when EnableExplanations is true
+	Unknown     Unknown  `json:"-"`           // Only when WithUnknownFields is used
 }
 
 type SuggestMethod string
@@ -39,12 +44,55 @@ const (
 	SuggestMethodRedFlags                 SuggestMethod = "red_flags"                   // Red flags
 )
 
+func (sm *SuggestMethod) IsValid() error {
+	_, err := SuggestMethodFromString(string(*sm))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func SuggestMethodFromString(x string) (SuggestMethod, error) {
+	switch strings.ToLower(x) {
+	case "symptoms":
+		return SuggestMethodSymptoms, nil
+	case "risk_factors":
+		return SuggestMethodRiskFactors, nil
+	case "demographic_risk_factors":
+		return SuggestMethoddemoGraphicRiskFactors, nil
+	case "evidence_based_risk_factors":
+		return SuggestMethodEvidenceBasedRiskFactors, nil
+	case "red_flags":
+		return SuggestMethodRedFlags, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for suggest method: %q", x)
+	}
+}
+
 // Suggest is a func to request suggestions
-func (a *App) Suggest(sr SuggestReq) (*[]SuggestRes, error) {
+func (a *App) Suggest(sr SuggestReq, opts ...RequestOption) (*[]SuggestRes, error) {
 	if sr.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: Unexpected value for Sex")
 	}
-	req, err := a.prepareRequest("POST", "suggest", sr)
+	if sr.SuggestMethod != "" {
+		err := sr.SuggestMethod.IsValid()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if sr.SuggestMethod == SuggestMethodRiskFactors {
+		log.Print("infermedica: suggest method risk_factors is deprecated since API 3.5, use demographic_risk_factors or evidence_based_risk_factors")
+	}
+	if sr.MaxResults < 0 {
+		return nil, fmt.Errorf("infermedica: MaxResults can not be less than zero")
+	}
+	if sr.EvaluatedAt != "" {
+		err := ValidateTimeline(sr.EvaluatedAt, sr.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "suggest", sr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +112,7 @@ func (a *App) Suggest(sr SuggestReq) (*[]SuggestRes, error) {
 	}
 
 	var r []SuggestRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
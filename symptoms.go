@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"net/http"
 	"strconv"
 	"time"
@@ -12,7 +11,7 @@ type SymptomRes struct {
 	Name                string         `json:"name"`
 	CommonName          string         `json:"common_name"`
 	Category            string         `json:"category"`
-	Seriousness         string         `json:"seriousness"`
+	Seriousness         Seriousness    `json:"seriousness"`
 	Children            []SymptomChild `json:"children"`
 	ImageURL            string         `json:"image_url"`
 	ImageSource         string         `json:"image_source"`
@@ -22,6 +21,7 @@ type SymptomRes struct {
 	QuestionThirdPerson string         `json:"question_third_person"`
 	SexFilter           SexFilter      `json:"sex_filter"`
 	Extra               any            `json:"extra"`
+	Unknown             Unknown        `json:"-"` // Only when WithUnknownFields is used
 }
 
 type SymptomChild struct {
@@ -29,8 +29,8 @@ type SymptomChild struct {
 	ParentRelation string `json:"parent_relation"`
 }
 
-func (a *App) Symptoms(age Age, enableTriage3 bool) (*[]SymptomRes, error) {
-	req, err := a.prepareRequest("GET", "symptoms?age.value="+strconv.Itoa(age.Value)+"&age.unit"+string(age.Unit)+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil)
+func (a *App) Symptoms(age Age, enableTriage3 bool, opts ...RequestOption) (*[]SymptomRes, error) {
+	req, err := a.prepareRequest("GET", "symptoms?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -50,15 +50,15 @@ func (a *App) Symptoms(age Age, enableTriage3 bool) (*[]SymptomRes, error) {
 	}
 
 	var r []SymptomRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-func (a *App) SymptomByID(id string, age Age, enableTriage3 bool) (*SymptomRes, error) {
-	req, err := a.prepareRequest("GET", "symptoms/"+id+"?age.value="+strconv.Itoa(age.Value)+"&age.unit"+string(age.Unit)+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil)
+func (a *App) SymptomByID(id string, age Age, enableTriage3 bool, opts ...RequestOption) (*SymptomRes, error) {
+	req, err := a.prepareRequest("GET", "symptoms/"+id+"?"+age.query()+"&enableTriage3="+strconv.FormatBool(enableTriage3), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (a *App) SymptomByID(id string, age Age, enableTriage3 bool) (*SymptomRes,
 	}
 
 	var r SymptomRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,167 @@
+package infermedica
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimeFormat is the format of ObservedAt and EvaluatedAt expected by the API
+const TimeFormat = time.RFC3339
+
+// FormatTime formats a time for ObservedAt or EvaluatedAt
+func FormatTime(t time.Time) string {
+	return t.Format(TimeFormat)
+}
+
+// ParseTime parses ObservedAt or EvaluatedAt, dates without a time ("2006-01-02") are accepted too
+func ParseTime(s string) (time.Time, error) {
+	t, err := time.Parse(TimeFormat, s)
+	if err == nil {
+		return t, nil
+	}
+	t, err = time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("infermedica: unexpected time format: %q", s)
+	}
+	return t, nil
+}
+
+// SetObservedAt sets when the evidence was observed
+func (e *Evidence) SetObservedAt(t time.Time) {
+	e.ObservedAt = FormatTime(t)
+}
+
+// ObservedTime returns when the evidence was observed, ok is false when ObservedAt is empty
+func (e *Evidence) ObservedTime() (t time.Time, ok bool, err error) {
+	if e.ObservedAt == "" {
+		return time.Time{}, false, nil
+	}
+	t, err = ParseTime(e.ObservedAt)
+	return t, err == nil, err
+}
+
+// ValidateTimeline returns an error when an evidence is observed after the evaluation time, or when
+// evaluatedAt or ObservedAt are not in TimeFormat or a date. When either side is a date without a time
+// they are compared by day, so evidence observed during the day of a date-only evaluation is accepted.
+// Every request with an EvaluatedAt runs it before calling the API. An empty evaluatedAt means now
+func ValidateTimeline(evaluatedAt string, evidences []Evidence) error {
+	evaluated, evaluatedDate := time.Now(), false
+	if evaluatedAt == "" {
+		evaluatedAt = FormatTime(evaluated)
+	} else {
+		t, err := ParseTime(evaluatedAt)
+		if err != nil {
+			return err
+		}
+		evaluated, evaluatedDate = t, isDateOnly(evaluatedAt)
+	}
+	for i := range evidences {
+		observed, ok, err := evidences[i].ObservedTime()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		after := observed.After(evaluated)
+		if evaluatedDate || isDateOnly(evidences[i].ObservedAt) {
+			after = day(observed).After(day(evaluated))
+		}
+		if after {
+			return fmt.Errorf("infermedica: evidence %q observed at %s after the evaluation at %s", evidences[i].ID, evidences[i].ObservedAt, evaluatedAt)
+		}
+	}
+	return nil
+}
+
+// isDateOnly reports whether s is a date without a time
+func isDateOnly(s string) bool {
+	_, err := time.Parse(time.DateOnly, s)
+	return err == nil
+}
+
+// day returns the date of t, in the location of t, as midnight UTC
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// HistoryEntry is an observation of a dated patient history
+type HistoryEntry struct {
+	ID         string
+	ChoiceID   EvidenceChoiceID
+	ObservedAt time.Time
+	Source     EvidenceSource
+	Duration   *Duration
+}
+
+// EvidenceAt builds the evidence known at the evaluation time from a dated patient history, so a past
+// assessment can be repeated with the same result. Entries observed after evaluatedAt are ignored and
+// the latest entry of an observation wins, even when its choice is unknown: unlike MergeEvidence no
+// conflict rule applies. It returns the EvaluatedAt to send with the evidence
+func EvidenceAt(history []HistoryEntry, evaluatedAt time.Time) ([]Evidence, string) {
+	entries := make([]HistoryEntry, 0, len(history))
+	for _, h := range history {
+		if !h.ObservedAt.After(evaluatedAt) {
+			entries = append(entries, h)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ObservedAt.Before(entries[j].ObservedAt)
+	})
+
+	evidences := make([]Evidence, 0, len(entries))
+	index := map[string]int{}
+	for _, h := range entries {
+		e := Evidence{
+			ID:       h.ID,
+			ChoiceID: h.ChoiceID,
+			Source:   h.Source,
+			Duration: h.Duration,
+		}
+		e.SetObservedAt(h.ObservedAt)
+		if i, ok := index[h.ID]; ok {
+			evidences[i] = e
+			continue
+		}
+		index[h.ID] = len(evidences)
+		evidences = append(evidences, e)
+	}
+	return evidences, FormatTime(evaluatedAt)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *DiagnosisReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *TriageReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *LabTestsReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *ExplainReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *RationaleReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *RecommendSpecialistReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
+
+// SetEvaluatedAt sets the time the assessment is made for
+func (r *SuggestReq) SetEvaluatedAt(t time.Time) {
+	r.EvaluatedAt = FormatTime(t)
+}
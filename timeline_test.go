@@ -0,0 +1,32 @@
+package infermedica
+
+import "testing"
+
+func TestValidateTimeline(t *testing.T) {
+	tests := []struct {
+		name        string
+		evaluatedAt string
+		observedAt  string
+		wantErr     bool
+	}{
+		{name: "observed before", evaluatedAt: "2024-01-02T10:00:00Z", observedAt: "2024-01-01T10:00:00Z"},
+		{name: "observed after", evaluatedAt: "2024-01-01T10:00:00Z", observedAt: "2024-01-01T11:00:00Z", wantErr: true},
+		{name: "observed the same day as a date-only evaluation", evaluatedAt: "2024-01-01", observedAt: "2024-01-01T10:00:00Z"},
+		{name: "observed the day after a date-only evaluation", evaluatedAt: "2024-01-01", observedAt: "2024-01-02T00:00:00Z", wantErr: true},
+		{name: "date-only observation the same day", evaluatedAt: "2024-01-01T08:00:00Z", observedAt: "2024-01-01"},
+		{name: "date-only observation the day after", evaluatedAt: "2024-01-01T23:00:00Z", observedAt: "2024-01-02", wantErr: true},
+		{name: "both dates", evaluatedAt: "2024-01-01", observedAt: "2024-01-01"},
+		{name: "no observation time", evaluatedAt: "2024-01-01"},
+		{name: "invalid evaluation time", evaluatedAt: "01/01/2024", wantErr: true},
+		{name: "invalid observation time", evaluatedAt: "2024-01-01", observedAt: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evidences := []Evidence{{ID: "s_1", ChoiceID: EvidenceChoiceIDPresent, ObservedAt: tt.observedAt}}
+			err := ValidateTimeline(tt.evaluatedAt, evidences)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTimeline(%q, %q) error = %v, want error %v", tt.evaluatedAt, tt.observedAt, err, tt.wantErr)
+			}
+		})
+	}
+}
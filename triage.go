@@ -1,7 +1,6 @@
 package infermedica
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -25,7 +24,8 @@ type TriageRes struct {
 	TriageLevel                TriageLevel `json:"triage_level"`                // A classification of the case provided
 	Serious                    []Serious   `json:"serious"`                     // A list of serious observations
 	TeleconsultationApplicable bool        `json:"teleconsultation_applicable"` // The teleconsultation_applicable flag has been deprecated and will stop being supported in the near future
-	RootCause                  string      `json:"root_cause"`                  // A root cause that explains the internal rationale of the underlying triage algorithm
+	RootCause                  RootCause   `json:"root_cause"`                  // A root cause that explains the internal rationale of the underlying triage algorithm
+	Unknown                    Unknown     `json:"-"`                           // Only when WithUnknownFields is used
 }
 
 type Serious struct {
@@ -48,6 +48,43 @@ const (
 	RootCauseDiagnosisUnknown                RootCause = "diagnosis_unknown"
 )
 
+func (rc *RootCause) IsValid() error {
+	_, err := RootCauseFromString(string(*rc))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func RootCauseFromString(x string) (RootCause, error) {
+	switch strings.ToLower(x) {
+	case "emergency_evidence_present":
+		return RootCauseEmergencyEvidencePresent, nil
+	case "serious_evidence_present":
+		return RootCauseEmergencySeriousEvidencePresent, nil
+	case "emergency_condition_likely":
+		return RootCauseEmergencyConditionLikely, nil
+	case "emergency_condition_possible":
+		return RootCauseEmergencyConditionPossible, nil
+	case "consultation_condition_likely":
+		return RootCauseConsultationConditionLikely, nil
+	case "self_care_sufficient":
+		return RootCauseSelfCareSufficient, nil
+	case "diagnosis_unknown":
+		return RootCauseDiagnosisUnknown, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for root cause: %q", x)
+	}
+}
+
+func (rc RootCause) String() string {
+	return string(rc)
+}
+
+func (rc *RootCause) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, rc, RootCauseFromString)
+}
+
 type Seriousness string
 
 const (
@@ -56,6 +93,64 @@ const (
 	SeriousnessSeriousEmergencyAmbulance Seriousness = "emergency_ambulance"
 )
 
+func (s *Seriousness) IsValid() error {
+	_, err := SeriousnessFromString(string(*s))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func SeriousnessFromString(x string) (Seriousness, error) {
+	switch strings.ToLower(x) {
+	case "serious":
+		return SeriousnessSerious, nil
+	case "emergency":
+		return SeriousnessSeriousEmergency, nil
+	case "emergency_ambulance":
+		return SeriousnessSeriousEmergencyAmbulance, nil
+	default:
+		return "", fmt.Errorf("infermedica: unexpected value for seriousness: %q", x)
+	}
+}
+
+func (s Seriousness) String() string {
+	return string(s)
+}
+
+func (s *Seriousness) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, s, SeriousnessFromString)
+}
+
+// seriousnessRank orders seriousness from the least to the most urgent
+var seriousnessRank = map[Seriousness]int{
+	SeriousnessSerious:                   1,
+	SeriousnessSeriousEmergency:          2,
+	SeriousnessSeriousEmergencyAmbulance: 3,
+}
+
+// Compare returns -1 if s is less urgent than other, 1 if it is more urgent and 0 if they are equal.
+// Unknown values are less urgent than every known value
+func (s Seriousness) Compare(other Seriousness) int {
+	return compareRank(seriousnessRank[s], seriousnessRank[other])
+}
+
+// MoreUrgentThan reports whether s is more urgent than other
+func (s Seriousness) MoreUrgentThan(other Seriousness) bool {
+	return s.Compare(other) > 0
+}
+
+// MaxSeriousness returns the most urgent seriousness, or "" when none is given
+func MaxSeriousness(s ...Seriousness) Seriousness {
+	var max Seriousness
+	for _, x := range s {
+		if max == "" || x.MoreUrgentThan(max) {
+			max = x
+		}
+	}
+	return max
+}
+
 type TriageLevel string
 
 const (
@@ -91,12 +186,94 @@ func TriageLevelFromString(x string) (TriageLevel, error) {
 	}
 }
 
+func (s TriageLevel) String() string {
+	return string(s)
+}
+
+func (s *TriageLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, s, TriageLevelFromString)
+}
+
+// triageLevelRank orders triage levels from the least to the most urgent,
+// the 3-level triage (EnableTriage3) only uses emergency, consultation and self_care
+var triageLevelRank = map[TriageLevel]int{
+	TriageLevelSelfCare:           1,
+	TriageLevelConsultation:       2,
+	TriageLevelConsultation24:     3,
+	TriageLevelEmergency:          4,
+	TriageLevelEmergencyAmbulance: 5,
+}
+
+// Compare returns -1 if s is less urgent than other, 1 if it is more urgent and 0 if they are equal.
+// Levels are ranked on the 5-level scale: a 3-level emergency or consultation ranks as the 5-level value of the
+// same name, below emergency_ambulance or consultation_24 it may stand for. Map 3-level results with Triage5
+// before comparing them with 5-level ones. Unknown values are less urgent than every known value
+func (s TriageLevel) Compare(other TriageLevel) int {
+	return compareRank(triageLevelRank[s], triageLevelRank[other])
+}
+
+// MoreUrgentThan reports whether s is more urgent than other
+func (s TriageLevel) MoreUrgentThan(other TriageLevel) bool {
+	return s.Compare(other) > 0
+}
+
+// MaxTriageLevel returns the most urgent triage level, or "" when none is given
+func MaxTriageLevel(levels ...TriageLevel) TriageLevel {
+	var max TriageLevel
+	for _, l := range levels {
+		if max == "" || l.MoreUrgentThan(max) {
+			max = l
+		}
+	}
+	return max
+}
+
+// IsTriage3 reports whether the level is used by the 3-level triage
+func (s TriageLevel) IsTriage3() bool {
+	switch s {
+	case TriageLevelEmergency, TriageLevelConsultation, TriageLevelSelfCare:
+		return true
+	}
+	return false
+}
+
+// Triage3 maps a level of the 5-level triage to the 3-level triage returned when EnableTriage3 is true
+func (s TriageLevel) Triage3() TriageLevel {
+	switch s {
+	case TriageLevelEmergencyAmbulance, TriageLevelEmergency:
+		return TriageLevelEmergency
+	case TriageLevelConsultation24, TriageLevelConsultation:
+		return TriageLevelConsultation
+	}
+	return s
+}
+
+// Triage5 maps a level of the 3-level triage, returned when EnableTriage3 is true, to the most urgent
+// 5-level value it groups: emergency becomes emergency_ambulance and consultation becomes consultation_24,
+// so escalation decisions never underestimate a 3-level result. s must come from the 3-level triage,
+// as emergency and consultation are also 5-level values
+func (s TriageLevel) Triage5() TriageLevel {
+	switch s {
+	case TriageLevelEmergency:
+		return TriageLevelEmergencyAmbulance
+	case TriageLevelConsultation:
+		return TriageLevelConsultation24
+	}
+	return s
+}
+
 // Triage estimates triage level based on the provided patient information.
-func (a *App) Triage(tr TriageReq) (*TriageRes, error) {
+func (a *App) Triage(tr TriageReq, opts ...RequestOption) (*TriageRes, error) {
 	if tr.Sex.IsValid() != nil {
 		return nil, fmt.Errorf("infermedica: Unexpected value for Sex")
 	}
-	req, err := a.prepareRequest("POST", "triage", tr)
+	if tr.EvaluatedAt != "" {
+		err := ValidateTimeline(tr.EvaluatedAt, tr.Evidences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := a.prepareRequest("POST", "triage", tr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +293,7 @@ func (a *App) Triage(tr TriageReq) (*TriageRes, error) {
 	}
 
 	var r TriageRes
-	err = json.NewDecoder(res.Body).Decode(&r)
+	err = a.decode(res.Body, &r, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,120 @@
+package vignette
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Summary counts the results
+type Summary struct {
+	Total  int
+	Passed int
+	Failed int
+	Errors int
+}
+
+// Summarize counts passed, failed and errored vignettes
+func Summarize(results []Result) Summary {
+	s := Summary{Total: len(results)}
+	for i := range results {
+		switch {
+		case results[i].Err != nil:
+			s.Errors++
+		case results[i].Passed():
+			s.Passed++
+		default:
+			s.Failed++
+		}
+	}
+	return s
+}
+
+// WriteReport writes a plain text pass/fail report
+func WriteReport(w io.Writer, results []Result) error {
+	for _, r := range results {
+		status := "PASS"
+		switch {
+		case r.Err != nil:
+			status = "ERROR"
+		case !r.Passed():
+			status = "FAIL"
+		}
+		_, err := fmt.Fprintf(w, "%-5s %s (%s)\n", status, r.Vignette.ID, r.Duration.Round(time.Millisecond))
+		if err != nil {
+			return err
+		}
+		if r.Err != nil {
+			fmt.Fprintf(w, "      %v\n", r.Err)
+		}
+		for _, f := range r.Failures {
+			fmt.Fprintf(w, "      %s\n", f)
+		}
+	}
+	s := Summarize(results)
+	_, err := fmt.Fprintf(w, "\n%d vignettes: %d passed, %d failed, %d errors\n", s.Total, s.Passed, s.Failed, s.Errors)
+	return err
+}
+
+type junitTestSuite struct {
+	XMLName  xml.Name        `xml:"testsuite"`
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     float64         `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the results as a JUnit XML test suite, one test case per vignette
+func WriteJUnit(w io.Writer, name string, results []Result) error {
+	s := Summarize(results)
+	suite := junitTestSuite{
+		Name:     name,
+		Tests:    s.Total,
+		Failures: s.Failed,
+		Errors:   s.Errors,
+	}
+	for _, r := range results {
+		c := junitTestCase{
+			Name:      r.Vignette.ID,
+			ClassName: name,
+			Time:      r.Duration.Seconds(),
+		}
+		switch {
+		case r.Err != nil:
+			c.Error = &junitMessage{Message: r.Err.Error(), Text: r.Err.Error()}
+		case !r.Passed():
+			c.Failure = &junitMessage{Message: r.Failures[0], Text: strings.Join(r.Failures, "\n")}
+		}
+		suite.Time += c.Time
+		suite.Cases = append(suite.Cases, c)
+	}
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	err = e.Encode(suite)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,141 @@
+package vignette
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// Result is the outcome of one vignette
+type Result struct {
+	Vignette    Vignette
+	Conditions  []infermedica.Conditions // Top N conditions returned by Diagnosis
+	TriageLevel infermedica.TriageLevel
+	Failures    []string // Expectations that were not met
+	Err         error    // Set when the API could not be called, the vignette is then neither passed nor failed
+	Duration    time.Duration
+}
+
+// Passed reports whether the vignette ran and met every expectation
+func (r *Result) Passed() bool {
+	return r.Err == nil && len(r.Failures) == 0
+}
+
+// Runner replays vignettes through Diagnosis and Triage, each vignette is a new interview with its own Interview-Id
+type Runner struct {
+	App         *infermedica.App
+	Concurrency int // Number of vignettes run at the same time, 1 when zero
+	Options     []infermedica.RequestOption
+}
+
+// Run runs the vignettes and returns their results in the same order. Vignettes that did not start
+// before ctx is done have ctx.Err() as their error
+func (r *Runner) Run(ctx context.Context, vignettes []Vignette) []Result {
+	concurrency := r.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	results := make([]Result, len(vignettes))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range vignettes {
+		select {
+		case <-ctx.Done():
+			results[i] = Result{Vignette: vignettes[i], Err: ctx.Err()}
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = r.run(ctx, vignettes[i])
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+func (r *Runner) run(ctx context.Context, v Vignette) Result {
+	start := time.Now()
+	result := Result{Vignette: v}
+	defer func() {
+		result.Duration = time.Since(start)
+	}()
+
+	opts := append(r.Options[:len(r.Options):len(r.Options)], infermedica.WithInterviewID(newInterviewID()), infermedica.WithContext(ctx))
+
+	diagnosis, err := r.App.Diagnosis(infermedica.DiagnosisReq{
+		Sex:       v.Sex,
+		Age:       v.Age,
+		Evidences: v.Evidence,
+		Extras:    v.Extras,
+	}, opts...)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	var triageExtras *infermedica.TriageReqExtras
+	if v.Extras != nil {
+		triageExtras = &infermedica.TriageReqExtras{
+			EnableTriage3:         v.Extras.EnableTriage3,
+			EnableSymptomDuration: v.Extras.EnableSymptomDuration,
+		}
+	}
+	triage, err := r.App.Triage(infermedica.TriageReq{
+		Sex:       v.Sex,
+		Age:       v.Age,
+		Evidences: v.Evidence,
+		Extras:    triageExtras,
+	}, opts...)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	top := diagnosis.Conditions
+	if len(top) > v.Expected.topN() {
+		top = top[:v.Expected.topN()]
+	}
+	result.Conditions = top
+	result.TriageLevel = triage.TriageLevel
+	// expectations are 5-level, a 3-level result is compared as the most urgent level it groups
+	level := triage.TriageLevel
+	if v.Extras != nil && v.Extras.EnableTriage3 {
+		level = level.Triage5()
+	}
+	result.Failures = check(v.Expected, top, level)
+	return result
+}
+
+// check compares the results with the expectation
+func check(e Expectation, top []infermedica.Conditions, level infermedica.TriageLevel) []string {
+	var failures []string
+	for _, id := range e.Conditions {
+		found := false
+		for _, c := range top {
+			if c.ID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			failures = append(failures, fmt.Sprintf("condition %s not in the top %d", id, e.topN()))
+		}
+	}
+	if e.TriageLevel != "" && level.Compare(e.TriageLevel) < 0 {
+		failures = append(failures, fmt.Sprintf("triage level %s is less urgent than %s", level, e.TriageLevel))
+	}
+	return failures
+}
+
+func newInterviewID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
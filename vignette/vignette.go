@@ -0,0 +1,134 @@
+// Package vignette replays clinical vignettes through Diagnosis and Triage and checks the results
+// against the expectations of the medical team, for regression testing.
+package vignette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/guiarnaldo/infermedica-v3"
+)
+
+// Vignette is a test case: the patient, the evidence and the expected results
+type Vignette struct {
+	ID          string                          `json:"id"`
+	Description string                          `json:"description,omitempty"`
+	Sex         infermedica.Sex                 `json:"sex"`
+	Age         infermedica.Age                 `json:"age"`
+	Evidence    []infermedica.Evidence          `json:"evidence"`
+	Extras      *infermedica.DiagnosisReqExtras `json:"extras,omitempty"`
+	Expected    Expectation                     `json:"expected"`
+}
+
+// Expectation is what a vignette must produce to pass
+type Expectation struct {
+	TopN        int                     `json:"top_n,omitempty"`        // Number of conditions Conditions are looked for in, 3 when zero
+	Conditions  []string                `json:"conditions,omitempty"`   // Condition IDs that must all be in the top N
+	TriageLevel infermedica.TriageLevel `json:"triage_level,omitempty"` // The triage must be this level or more urgent, on the 5-level scale even when EnableTriage3 is set
+}
+
+func (e Expectation) topN() int {
+	if e.TopN <= 0 {
+		return 3
+	}
+	return e.TopN
+}
+
+// Load reads vignettes from a JSON Lines (.jsonl), JSON (.json, an array) or YAML (.yaml, .yml, a sequence) file
+func Load(path string) ([]Vignette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var vignettes []Vignette
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl":
+		vignettes, err = decodeJSONL(b)
+	case ".json":
+		err = json.Unmarshal(b, &vignettes)
+	case ".yaml", ".yml":
+		vignettes, err = decodeYAML(b)
+	default:
+		return nil, fmt.Errorf("vignette: unexpected file extension: %q", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("vignette: %s: %w", path, err)
+	}
+	for i, v := range vignettes {
+		if err := v.validate(); err != nil {
+			return nil, fmt.Errorf("vignette: %s: vignette %d: %w", path, i+1, err)
+		}
+	}
+	return vignettes, nil
+}
+
+func decodeJSONL(b []byte) ([]Vignette, error) {
+	var vignettes []Vignette
+	s := bufio.NewScanner(bytes.NewReader(b))
+	s.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for n := 1; s.Scan(); n++ {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var v Vignette
+		err := json.Unmarshal(line, &v)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		vignettes = append(vignettes, v)
+	}
+	return vignettes, s.Err()
+}
+
+func decodeYAML(b []byte) ([]Vignette, error) {
+	doc, err := parseYAML(b)
+	if err != nil {
+		return nil, err
+	}
+	// The document is converted to JSON so the vignettes are decoded with the same rules as the other formats
+	j, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var vignettes []Vignette
+	err = json.Unmarshal(j, &vignettes)
+	if err != nil {
+		return nil, err
+	}
+	return vignettes, nil
+}
+
+func (v *Vignette) validate() error {
+	if v.ID == "" {
+		return fmt.Errorf("missing id")
+	}
+	if err := v.Sex.IsValid(); err != nil {
+		return err
+	}
+	if err := v.Age.IsValid(); err != nil {
+		return err
+	}
+	if len(v.Evidence) == 0 {
+		return fmt.Errorf("missing evidence")
+	}
+	for i, e := range v.Evidence {
+		if e.ID == "" {
+			return fmt.Errorf("evidence[%d]: missing id", i)
+		}
+		if err := e.ChoiceID.IsValid(); err != nil {
+			return fmt.Errorf("evidence[%d] %s: %w", i, e.ID, err)
+		}
+	}
+	if v.Expected.TriageLevel != "" {
+		if err := v.Expected.TriageLevel.IsValid(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package vignette
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The standard library has no YAML support, vignette files only need the block subset of YAML:
+// mappings, sequences, flow sequences of scalars ([a, b]), quoted and plain scalars and comments.
+// Anything else (flow mappings, block scalars, anchors, aliases, tags, documents) is rejected with the
+// number of the line instead of being read as a string
+
+type yamlLine struct {
+	number  int
+	indent  int
+	content string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML parses a YAML document into maps, slices and scalars that can be encoded as JSON
+func parseYAML(b []byte) (interface{}, error) {
+	var p yamlParser
+	for i, raw := range strings.Split(string(b), "\n") {
+		line := strings.TrimRight(stripComment(raw), " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if line == "---" && len(p.lines) == 0 {
+			continue
+		}
+		if strings.Contains(line[:len(line)-len(strings.TrimLeft(line, " \t"))], "\t") {
+			return nil, fmt.Errorf("vignette: yaml line %d: tabs are not allowed in indentation", i+1)
+		}
+		content := strings.TrimLeft(line, " ")
+		p.lines = append(p.lines, yamlLine{number: i + 1, indent: len(line) - len(content), content: content})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+	v, err := p.block(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("vignette: yaml line %d: unexpected indentation", p.lines[p.pos].number)
+	}
+	return v, nil
+}
+
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	if isSequenceItem(p.lines[p.pos].content) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) sequence(indent int) (interface{}, error) {
+	var items []interface{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSequenceItem(p.lines[p.pos].content) {
+		line := p.lines[p.pos]
+		rest := strings.TrimSpace(strings.TrimPrefix(line.content, "-"))
+		if rest == "" {
+			p.pos++
+			v, err := p.nested(indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+			continue
+		}
+		if unsupported(rest) {
+			return nil, unsupportedError(rest, line.number)
+		}
+		if _, _, ok := splitKey(rest); ok {
+			// An inline mapping, e.g. "- id: s_1", continues on the following lines at the indentation of its first key
+			p.lines[p.pos] = yamlLine{number: line.number, indent: indent + len(line.content) - len(rest), content: rest}
+			v, err := p.mapping(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+			continue
+		}
+		v, err := scalar(rest, line.number)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+		p.pos++
+	}
+	return items, nil
+}
+
+func (p *yamlParser) mapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && !isSequenceItem(p.lines[p.pos].content) {
+		line := p.lines[p.pos]
+		if unsupported(line.content) {
+			return nil, unsupportedError(line.content, line.number)
+		}
+		key, value, ok := splitKey(line.content)
+		if !ok {
+			return nil, fmt.Errorf("vignette: yaml line %d: expected \"key: value\"", line.number)
+		}
+		p.pos++
+		if value != "" {
+			v, err := scalar(value, line.number)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			continue
+		}
+		// A sequence can be indented at the same level as its key
+		if p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSequenceItem(p.lines[p.pos].content) {
+			v, err := p.sequence(indent)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			continue
+		}
+		v, err := p.nested(indent)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+	return m, nil
+}
+
+// nested parses the block that follows a line ending in ":" or "-", or returns nil when it is empty
+func (p *yamlParser) nested(indent int) (interface{}, error) {
+	if p.pos >= len(p.lines) || p.lines[p.pos].indent <= indent {
+		return nil, nil
+	}
+	return p.block(p.lines[p.pos].indent)
+}
+
+func isSequenceItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+// splitKey splits "key: value", keys may be quoted
+func splitKey(content string) (key, value string, ok bool) {
+	if strings.HasPrefix(content, "\"") || strings.HasPrefix(content, "'") {
+		end := strings.IndexByte(content[1:], content[0])
+		if end < 0 {
+			return "", "", false
+		}
+		key = content[1 : end+1]
+		rest := content[end+2:]
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", false
+		}
+		return key, strings.TrimSpace(rest[1:]), true
+	}
+	i := strings.Index(content, ": ")
+	if i < 0 {
+		if strings.HasSuffix(content, ":") {
+			return content[:len(content)-1], "", true
+		}
+		return "", "", false
+	}
+	return content[:i], strings.TrimSpace(content[i+2:]), true
+}
+
+func scalar(s string, line int) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(s, "\""):
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("vignette: yaml line %d: %w", line, err)
+		}
+		return v, nil
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return nil, fmt.Errorf("vignette: yaml line %d: unterminated string", line)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return nil, fmt.Errorf("vignette: yaml line %d: unterminated sequence", line)
+		}
+		items := []interface{}{}
+		fields, err := splitFlow(s[1:len(s)-1], line)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if strings.HasPrefix(f, "[") {
+				return nil, fmt.Errorf("vignette: yaml line %d: nested flow sequences are not supported", line)
+			}
+			v, err := scalar(f, line)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		return items, nil
+	case s == "{}":
+		return map[string]interface{}{}, nil
+	case s == "null" || s == "~":
+		return nil, nil
+	case s == "true":
+		return true, nil
+	case s == "false":
+		return false, nil
+	}
+	if unsupported(s) {
+		return nil, unsupportedError(s, line)
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, nil
+	}
+	return s, nil
+}
+
+// unsupported reports whether a key or value starts with YAML syntax the parser does not handle:
+// flow mappings, block scalars (| and >), anchors, aliases, tags, directives, complex keys or document markers
+func unsupported(s string) bool {
+	if s == "{}" || s == "" {
+		return false
+	}
+	return strings.ContainsAny(s[:1], "{}]|>&*!%@`") || s == "?" || strings.HasPrefix(s, "? ") || s == "---" || s == "..."
+}
+
+func unsupportedError(s string, line int) error {
+	return fmt.Errorf("vignette: yaml line %d: unsupported syntax %q, use block mappings and sequences with plain or quoted scalars", line, s)
+}
+
+// splitFlow splits the inside of a flow sequence on the commas that are not quoted
+func splitFlow(s string, line int) ([]string, error) {
+	var fields []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ',':
+			fields = append(fields, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("vignette: yaml line %d: unterminated string", line)
+	}
+	last := strings.TrimSpace(s[start:])
+	if last == "" && len(fields) == 0 {
+		return nil, nil
+	}
+	fields = append(fields, last)
+	for _, f := range fields {
+		if f == "" {
+			return nil, fmt.Errorf("vignette: yaml line %d: empty item in flow sequence", line)
+		}
+	}
+	return fields, nil
+}
+
+// stripComment removes a "#" comment that is not inside a quoted string
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,161 @@
+package vignette
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want interface{}
+	}{
+		{
+			name: "mapping",
+			in:   "id: v1\nage:\n  value: 30\nsex: male # comment\n",
+			want: map[string]interface{}{"id": "v1", "age": map[string]interface{}{"value": int64(30)}, "sex": "male"},
+		},
+		{
+			name: "sequence of inline mappings",
+			in:   "- id: s_1\n  choice_id: present\n- id: s_2\n  choice_id: absent\n",
+			want: []interface{}{
+				map[string]interface{}{"id": "s_1", "choice_id": "present"},
+				map[string]interface{}{"id": "s_2", "choice_id": "absent"},
+			},
+		},
+		{
+			name: "sequence at the indentation of its key",
+			in:   "evidence:\n- id: s_1\nid: v1\n",
+			want: map[string]interface{}{"evidence": []interface{}{map[string]interface{}{"id": "s_1"}}, "id": "v1"},
+		},
+		{
+			name: "flow sequence with quoted commas",
+			in:   `conditions: [c_1, "c, 2", 'c_3', 4]`,
+			want: map[string]interface{}{"conditions": []interface{}{"c_1", "c, 2", "c_3", int64(4)}},
+		},
+		{
+			name: "scalars",
+			in:   "a: true\nb: ~\nc: 1.5\nd: \"x # y\"\ne: 'it''s'\nf: {}\ng: []\n",
+			want: map[string]interface{}{"a": true, "b": nil, "c": 1.5, "d": "x # y", "e": "it's", "f": map[string]interface{}{}, "g": []interface{}{}},
+		},
+		{
+			name: "leading document marker",
+			in:   "---\nid: v1\n",
+			want: map[string]interface{}{"id": "v1"},
+		},
+		{
+			name: "empty",
+			in:   "# nothing\n\n",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseYAML([]byte(tt.in))
+			if err != nil {
+				t.Fatalf("parseYAML() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseYAML() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		line string
+	}{
+		{"flow mapping in sequence", "evidence:\n  - {id: s_2, choice_id: absent}\n", "line 2"},
+		{"flow mapping value", "age: {value: 30}\n", "line 1"},
+		{"literal block scalar", "description: |\n  text\n", "line 1"},
+		{"folded block scalar", "description: >-\n  text\n", "line 1"},
+		{"anchor", "age: &a 30\n", "line 1"},
+		{"alias", "age: *a\n", "line 1"},
+		{"tag", "age: !!int 30\n", "line 1"},
+		{"complex key", "? id\n: v1\n", "line 1"},
+		{"second document", "id: v1\n---\nid: v2\n", "line 2"},
+		{"nested flow sequence", "a: [[1, 2]]\n", "line 1"},
+		{"unterminated flow sequence", "a: [1, 2\n", "line 1"},
+		{"unterminated string", "a: 'x\n", "line 1"},
+		{"empty flow item", "a: [1, , 2]\n", "line 1"},
+		{"tab indentation", "a:\n\tb: 1\n", "line 2"},
+		{"bad indentation", "a: 1\n  b: 2\n", "line 2"},
+		{"not a mapping", "a: 1\nb\n", "line 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseYAML([]byte(tt.in))
+			if err == nil {
+				t.Fatal("parseYAML() error = nil, want an error")
+			}
+			if !strings.Contains(err.Error(), tt.line) {
+				t.Errorf("parseYAML() error = %q, want it to contain %q", err, tt.line)
+			}
+		})
+	}
+}
+
+func TestLoadYAML(t *testing.T) {
+	const doc = `
+- id: chest-pain
+  sex: male
+  age:
+    value: 55
+  evidence:
+    - id: s_50
+      choice_id: present
+      source: initial
+  expected:
+    conditions: [c_49]
+    triage_level: emergency
+`
+	path := filepath.Join(t.TempDir(), "vignettes.yaml")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	vignettes, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(vignettes) != 1 {
+		t.Fatalf("Load() returned %d vignettes, want 1", len(vignettes))
+	}
+	v := vignettes[0]
+	if v.Age.Value != 55 || len(v.Evidence) != 1 || v.Evidence[0].ID != "s_50" || v.Evidence[0].ChoiceID != "present" {
+		t.Errorf("Load() = %+v", v)
+	}
+	if v.Expected.TriageLevel != "emergency" || len(v.Expected.Conditions) != 1 {
+		t.Errorf("Load() expected = %+v", v.Expected)
+	}
+}
+
+func TestLoadInvalidEvidence(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"missing evidence id", "- id: v1\n  sex: male\n  age:\n    value: 30\n  evidence:\n    - choice_id: present\n", "evidence[0]: missing id"},
+		{"bad choice", "- id: v1\n  sex: male\n  age:\n    value: 30\n  evidence:\n    - id: s_1\n      choice_id: maybe\n", "evidence[0] s_1"},
+		{"no evidence", "- id: v1\n  sex: male\n  age:\n    value: 30\n", "missing evidence"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vignettes.yml")
+			if err := os.WriteFile(path, []byte(tt.doc), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Load(path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}